@@ -0,0 +1,423 @@
+// Package bvh provides a bounding volume hierarchy for accelerating ray casts
+// and overlap queries against sets of primitives, such as the triangles of a
+// mesh.
+package bvh
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Primitive is anything that can be stored within a BVH
+type Primitive interface {
+	Bounds() vector3.AABB[float64]
+}
+
+// RayTest determines whether or not a ray intersects a primitive, and if so,
+// the distance along the ray at which it does
+type RayTest[P Primitive] func(primitive P, origin, direction vector3.Float64) (float64, bool)
+
+// SplitMethod determines how primitives are divided between the children of
+// a node while building the hierarchy
+type SplitMethod int
+
+const (
+	// SAH splits nodes using the surface area heuristic, which is slower to
+	// build but produces trees that are faster to traverse
+	SAH SplitMethod = iota
+
+	// Median splits nodes at the median centroid along their longest axis,
+	// which is fast to build
+	Median
+)
+
+const sahBins = 16
+
+// node is stored in a flattened depth first layout. Interior nodes keep
+// their left child directly after themselves, and store the index of their
+// right child in offset. Leaf nodes store where their primitives begin
+// within the BVH's indices in offset.
+type node struct {
+	bounds vector3.AABB[float64]
+	offset int
+	count  int
+	axis   int
+}
+
+func (n node) leaf() bool {
+	return n.count > 0
+}
+
+// BVH is a bounding volume hierarchy built over a set of primitives. Queries
+// return indices into the original slice of primitives.
+type BVH[P Primitive] struct {
+	primitives []P
+	indices    []int
+	nodes      []node
+}
+
+// New builds a hierarchy over the primitives provided, splitting nodes until
+// they contain at most maxLeafSize primitives. The slice of primitives is
+// copied, so later changes to it don't affect the hierarchy, and SetPrimitive
+// never modifies it.
+func New[P Primitive](primitives []P, method SplitMethod, maxLeafSize int) *BVH[P] {
+	if method != SAH && method != Median {
+		panic(fmt.Errorf("unknown split method: %d", method))
+	}
+
+	b := &BVH[P]{
+		primitives: slices.Clone(primitives),
+		indices:    make([]int, len(primitives)),
+		nodes:      make([]node, 0, max(1, 2*len(primitives)-1)),
+	}
+
+	if len(primitives) == 0 {
+		return b
+	}
+
+	bounds := make([]vector3.AABB[float64], len(primitives))
+	centroids := make([]vector3.Float64, len(primitives))
+	for i, p := range primitives {
+		b.indices[i] = i
+		bounds[i] = p.Bounds()
+		centroids[i] = bounds[i].Center()
+	}
+
+	builder := builder{
+		indices:     b.indices,
+		bounds:      bounds,
+		centroids:   centroids,
+		method:      method,
+		maxLeafSize: max(1, maxLeafSize),
+	}
+	builder.build(0, len(primitives))
+	b.nodes = builder.nodes
+	return b
+}
+
+type builder struct {
+	indices     []int
+	bounds      []vector3.AABB[float64]
+	centroids   []vector3.Float64
+	method      SplitMethod
+	maxLeafSize int
+	nodes       []node
+}
+
+func (b *builder) build(start, end int) int {
+	nodeIndex := len(b.nodes)
+	b.nodes = append(b.nodes, node{})
+
+	bounds := b.bounds[b.indices[start]]
+	centroidBounds := vector3.NewAABB(b.centroids[b.indices[start]], b.centroids[b.indices[start]])
+	for _, i := range b.indices[start+1 : end] {
+		bounds = bounds.Union(b.bounds[i])
+		centroidBounds = centroidBounds.Include(b.centroids[i])
+	}
+
+	count := end - start
+	axis := centroidBounds.LongestAxis()
+
+	// All centroids in the same spot, there's no way to divide them
+	if count <= b.maxLeafSize && b.method == Median || centroidBounds.Size().Component(axis) == 0 {
+		b.nodes[nodeIndex] = node{bounds: bounds, offset: start, count: count}
+		return nodeIndex
+	}
+
+	var mid int
+	switch b.method {
+	case SAH:
+		var ok bool
+		axis, mid, ok = b.sahSplit(start, end, bounds, centroidBounds)
+		if !ok {
+			b.nodes[nodeIndex] = node{bounds: bounds, offset: start, count: count}
+			return nodeIndex
+		}
+
+	case Median:
+		mid = (start + end) / 2
+		b.sortAlong(start, end, axis)
+	}
+
+	b.build(start, mid)
+	right := b.build(mid, end)
+	b.nodes[nodeIndex] = node{bounds: bounds, offset: right, axis: axis}
+	return nodeIndex
+}
+
+func (b *builder) sortAlong(start, end, axis int) {
+	indices := b.indices[start:end]
+	sort.Slice(indices, func(i, j int) bool {
+		return b.centroids[indices[i]].Component(axis) < b.centroids[indices[j]].Component(axis)
+	})
+}
+
+type bin struct {
+	bounds vector3.AABB[float64]
+	count  int
+}
+
+// sahSplit buckets primitives by centroid along each axis, and picks the
+// bucket boundary with the lowest estimated traversal cost. Returns false if
+// keeping the primitives together in a leaf is cheaper than any split.
+func (b *builder) sahSplit(start, end int, bounds, centroidBounds vector3.AABB[float64]) (axis, mid int, ok bool) {
+	count := end - start
+	bestCost := math.Inf(1)
+	bestAxis := -1
+	bestSplit := 0
+
+	cMin := centroidBounds.Min()
+	cSize := centroidBounds.Size()
+
+	binIndex := func(i, axis int) int {
+		offset := (b.centroids[i].Component(axis) - cMin.Component(axis)) / cSize.Component(axis)
+		return min(sahBins-1, int(offset*sahBins))
+	}
+
+	for axis := 0; axis < 3; axis++ {
+		if cSize.Component(axis) == 0 {
+			continue
+		}
+
+		var bins [sahBins]bin
+		for _, i := range b.indices[start:end] {
+			bi := binIndex(i, axis)
+			if bins[bi].count == 0 {
+				bins[bi].bounds = b.bounds[i]
+			} else {
+				bins[bi].bounds = bins[bi].bounds.Union(b.bounds[i])
+			}
+			bins[bi].count++
+		}
+
+		// Sweep from the right, recording the cost of everything right of
+		// each split
+		var rightArea [sahBins]float64
+		var rightCount [sahBins]int
+		var accum vector3.AABB[float64]
+		accumCount := 0
+		for i := sahBins - 1; i > 0; i-- {
+			if bins[i].count > 0 {
+				if accumCount == 0 {
+					accum = bins[i].bounds
+				} else {
+					accum = accum.Union(bins[i].bounds)
+				}
+				accumCount += bins[i].count
+			}
+			rightArea[i] = accum.SurfaceArea()
+			rightCount[i] = accumCount
+		}
+
+		accumCount = 0
+		for i := 0; i < sahBins-1; i++ {
+			if bins[i].count > 0 {
+				if accumCount == 0 {
+					accum = bins[i].bounds
+				} else {
+					accum = accum.Union(bins[i].bounds)
+				}
+				accumCount += bins[i].count
+			}
+
+			if accumCount == 0 || rightCount[i+1] == 0 {
+				continue
+			}
+
+			cost := accum.SurfaceArea()*float64(accumCount) + rightArea[i+1]*float64(rightCount[i+1])
+			if cost < bestCost {
+				bestCost = cost
+				bestAxis = axis
+				bestSplit = i
+			}
+		}
+	}
+
+	if bestAxis == -1 {
+		return 0, 0, false
+	}
+
+	// Relative to the cost of intersecting every primitive in a leaf, with
+	// traversing a node being estimated as an eighth of a primitive test
+	area := bounds.SurfaceArea()
+	splitCost := 0.125 + bestCost/area
+	if area == 0 {
+		splitCost = 0.125 + float64(count)/2
+	}
+	if count <= b.maxLeafSize && splitCost >= float64(count) {
+		return 0, 0, false
+	}
+
+	// Partition the indices by bin
+	indices := b.indices[start:end]
+	left, right := 0, len(indices)-1
+	for left <= right {
+		if binIndex(indices[left], bestAxis) <= bestSplit {
+			left++
+		} else {
+			indices[left], indices[right] = indices[right], indices[left]
+			right--
+		}
+	}
+
+	return bestAxis, start + left, true
+}
+
+// Len returns the number of primitives within the hierarchy
+func (b *BVH[P]) Len() int {
+	return len(b.primitives)
+}
+
+// Bounds returns the bounding box encompassing every primitive
+func (b *BVH[P]) Bounds() vector3.AABB[float64] {
+	if len(b.nodes) == 0 {
+		return vector3.AABB[float64]{}
+	}
+	return b.nodes[0].bounds
+}
+
+// Primitive returns the primitive found at the index of the original slice
+func (b *BVH[P]) Primitive(index int) P {
+	return b.primitives[index]
+}
+
+// SetPrimitive replaces the primitive found at the index of the original
+// slice. Refit must be called before querying the hierarchy again.
+func (b *BVH[P]) SetPrimitive(index int, primitive P) {
+	b.primitives[index] = primitive
+}
+
+// Refit recomputes the bounds of every node after primitives have moved,
+// without changing the structure of the hierarchy. This is much faster than
+// rebuilding, at the cost of traversal performance degrading the farther
+// primitives travel from where they were when the hierarchy was built.
+func (b *BVH[P]) Refit() {
+	// Children always come after their parents, so walking backwards
+	// guarantees children are refit before the parents that depend on them
+	for i := len(b.nodes) - 1; i >= 0; i-- {
+		n := &b.nodes[i]
+		if n.leaf() {
+			n.bounds = b.primitives[b.indices[n.offset]].Bounds()
+			for _, index := range b.indices[n.offset+1 : n.offset+n.count] {
+				n.bounds = n.bounds.Union(b.primitives[index].Bounds())
+			}
+			continue
+		}
+		n.bounds = b.nodes[i+1].bounds.Union(b.nodes[n.offset].bounds)
+	}
+}
+
+// traverse visits every leaf whose bounds are hit by the ray closer than the
+// distance returned by limit, visiting nearer children first. Visiting stops
+// once visit returns true.
+func (b *BVH[P]) traverse(origin, direction vector3.Float64, limit func() float64, visit func(index int) bool) {
+	if len(b.nodes) == 0 {
+		return
+	}
+
+	stack := make([]int, 1, 64)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n := b.nodes[current]
+		tMin, _, hit := n.bounds.IntersectRay(origin, direction)
+		if !hit || tMin > limit() {
+			continue
+		}
+
+		if n.leaf() {
+			for _, index := range b.indices[n.offset : n.offset+n.count] {
+				if visit(index) {
+					return
+				}
+			}
+			continue
+		}
+
+		// Push the far child first so the near child is popped next
+		if direction.Component(n.axis) < 0 {
+			stack = append(stack, current+1, n.offset)
+		} else {
+			stack = append(stack, n.offset, current+1)
+		}
+	}
+}
+
+// ClosestHit finds the primitive the ray intersects nearest to its origin,
+// within maxDistance. The index of the primitive and the distance along the
+// ray are returned.
+func (b *BVH[P]) ClosestHit(origin, direction vector3.Float64, maxDistance float64, test RayTest[P]) (index int, distance float64, hit bool) {
+	index = -1
+	distance = maxDistance
+	b.traverse(origin, direction,
+		func() float64 { return distance },
+		func(i int) bool {
+			if t, ok := test(b.primitives[i], origin, direction); ok && t >= 0 && t <= distance {
+				index = i
+				distance = t
+				hit = true
+			}
+			return false
+		},
+	)
+	if !hit {
+		distance = 0
+	}
+	return
+}
+
+// AnyHit returns the index of the first primitive found that the ray
+// intersects within maxDistance. This is typically used for shadow and
+// visibility tests, where which primitive is hit doesn't matter.
+func (b *BVH[P]) AnyHit(origin, direction vector3.Float64, maxDistance float64, test RayTest[P]) (index int, hit bool) {
+	index = -1
+	b.traverse(origin, direction,
+		func() float64 { return maxDistance },
+		func(i int) bool {
+			if t, ok := test(b.primitives[i], origin, direction); ok && t >= 0 && t <= maxDistance {
+				index = i
+				hit = true
+				return true
+			}
+			return false
+		},
+	)
+	return
+}
+
+// Overlapping returns the indices of all primitives whose bounds intersect
+// the bounding box provided, in no particular order
+func (b *BVH[P]) Overlapping(box vector3.AABB[float64]) []int {
+	out := make([]int, 0)
+	if len(b.nodes) == 0 {
+		return out
+	}
+
+	stack := make([]int, 1, 64)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n := b.nodes[current]
+		if !n.bounds.Intersects(box) {
+			continue
+		}
+
+		if n.leaf() {
+			for _, index := range b.indices[n.offset : n.offset+n.count] {
+				if b.primitives[index].Bounds().Intersects(box) {
+					out = append(out, index)
+				}
+			}
+			continue
+		}
+
+		stack = append(stack, n.offset, current+1)
+	}
+	return out
+}
@@ -0,0 +1,267 @@
+package bvh_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/bvh"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomTriangles(r *rand.Rand, n int) []bvh.Triangle {
+	triangles := make([]bvh.Triangle, n)
+	for i := range triangles {
+		center := vector3.RandRange(r, -100., 100.)
+		triangles[i] = bvh.Triangle{
+			A: center.Add(vector3.RandRange(r, -3., 3.)),
+			B: center.Add(vector3.RandRange(r, -3., 3.)),
+			C: center.Add(vector3.RandRange(r, -3., 3.)),
+		}
+	}
+	return triangles
+}
+
+func randomRay(r *rand.Rand) (vector3.Float64, vector3.Float64) {
+	origin := vector3.RandRange(r, -120., 120.)
+	target := vector3.RandRange(r, -50., 50.)
+	return origin, target.Sub(origin).Normalized()
+}
+
+func bruteForceClosest(triangles []bvh.Triangle, origin, direction vector3.Float64, maxDistance float64) (int, float64) {
+	best := -1
+	bestDistance := maxDistance
+	for i, tri := range triangles {
+		if d, ok := tri.IntersectRay(origin, direction); ok && d <= bestDistance {
+			best = i
+			bestDistance = d
+		}
+	}
+	return best, bestDistance
+}
+
+var splitMethods = map[string]bvh.SplitMethod{
+	"sah":    bvh.SAH,
+	"median": bvh.Median,
+}
+
+func TestClosestHit_MatchesBruteForce(t *testing.T) {
+	for name, method := range splitMethods {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			triangles := randomTriangles(r, 2000)
+			tree := bvh.New(triangles, method, 4)
+			assert.Equal(t, len(triangles), tree.Len())
+
+			hits := 0
+			for i := 0; i < 500; i++ {
+				origin, direction := randomRay(r)
+				want, wantDistance := bruteForceClosest(triangles, origin, direction, math.Inf(1))
+
+				got, distance, hit := tree.ClosestHit(origin, direction, math.Inf(1), bvh.Triangle.IntersectRay)
+				assert.Equal(t, want != -1, hit)
+				if hit {
+					hits++
+					assert.InDelta(t, wantDistance, distance, 1e-9)
+					assert.Equal(t, triangles[want], tree.Primitive(got))
+				}
+
+				_, anyHit := tree.AnyHit(origin, direction, math.Inf(1), bvh.Triangle.IntersectRay)
+				assert.Equal(t, hit, anyHit)
+			}
+			assert.Greater(t, hits, 0)
+		})
+	}
+}
+
+func TestHit_RespectsMaxDistance(t *testing.T) {
+	triangles := []bvh.Triangle{
+		{A: vector3.New(-1., -1., 5.), B: vector3.New(1., -1., 5.), C: vector3.New(0., 1., 5.)},
+		{A: vector3.New(-1., -1., 10.), B: vector3.New(1., -1., 10.), C: vector3.New(0., 1., 10.)},
+	}
+	tree := bvh.New(triangles, bvh.SAH, 1)
+	origin := vector3.Zero[float64]()
+	direction := vector3.Forward[float64]()
+
+	index, distance, hit := tree.ClosestHit(origin, direction, 100, bvh.Triangle.IntersectRay)
+	assert.True(t, hit)
+	assert.Equal(t, 0, index)
+	assert.InDelta(t, 5., distance, 1e-9)
+
+	_, _, hit = tree.ClosestHit(origin, direction, 4, bvh.Triangle.IntersectRay)
+	assert.False(t, hit)
+
+	_, hit = tree.AnyHit(origin, direction, 4, bvh.Triangle.IntersectRay)
+	assert.False(t, hit)
+
+	index, hit = tree.AnyHit(origin, direction, 6, bvh.Triangle.IntersectRay)
+	assert.True(t, hit)
+	assert.Equal(t, 0, index)
+
+	index, distance, hit = tree.ClosestHit(vector3.New(0., 0., 7.), direction, 100, bvh.Triangle.IntersectRay)
+	assert.True(t, hit)
+	assert.Equal(t, 1, index)
+	assert.InDelta(t, 3., distance, 1e-9)
+}
+
+func bruteForceOverlapping(triangles []bvh.Triangle, box vector3.AABB[float64]) []int {
+	out := make([]int, 0)
+	for i, tri := range triangles {
+		if tri.Bounds().Intersects(box) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func TestOverlapping_MatchesBruteForce(t *testing.T) {
+	for name, method := range splitMethods {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			triangles := randomTriangles(r, 2000)
+			tree := bvh.New(triangles, method, 4)
+
+			for i := 0; i < 100; i++ {
+				box := vector3.NewAABBFromCenter(vector3.RandRange(r, -100., 100.), vector3.RandRange(r, 0., 40.))
+				got := tree.Overlapping(box)
+				sort.Ints(got)
+				assert.Equal(t, bruteForceOverlapping(triangles, box), got)
+			}
+		})
+	}
+}
+
+func TestRefit_MatchesBruteForce(t *testing.T) {
+	for name, method := range splitMethods {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			triangles := randomTriangles(r, 1000)
+			tree := bvh.New(triangles, method, 4)
+
+			moved := make([]bvh.Triangle, len(triangles))
+			for i, tri := range triangles {
+				offset := vector3.RandRange(r, -20., 20.)
+				moved[i] = bvh.Triangle{A: tri.A.Add(offset), B: tri.B.Add(offset), C: tri.C.Add(offset)}
+				tree.SetPrimitive(i, moved[i])
+			}
+			tree.Refit()
+
+			for i := 0; i < 200; i++ {
+				origin, direction := randomRay(r)
+				want, wantDistance := bruteForceClosest(moved, origin, direction, math.Inf(1))
+				got, distance, hit := tree.ClosestHit(origin, direction, math.Inf(1), bvh.Triangle.IntersectRay)
+				assert.Equal(t, want != -1, hit)
+				if hit {
+					assert.InDelta(t, wantDistance, distance, 1e-9)
+					assert.Equal(t, moved[want], tree.Primitive(got))
+				}
+			}
+
+			box := vector3.NewAABBFromCenter(vector3.Zero[float64](), vector3.Fill(60.))
+			got := tree.Overlapping(box)
+			sort.Ints(got)
+			assert.Equal(t, bruteForceOverlapping(moved, box), got)
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	tree := bvh.New([]bvh.Triangle{}, bvh.SAH, 4)
+	assert.Equal(t, 0, tree.Len())
+	assert.Len(t, tree.Overlapping(vector3.NewAABB(vector3.Fill(-1.), vector3.Fill(1.))), 0)
+
+	_, _, hit := tree.ClosestHit(vector3.Zero[float64](), vector3.Forward[float64](), 10, bvh.Triangle.IntersectRay)
+	assert.False(t, hit)
+	tree.Refit()
+}
+
+func TestSetPrimitive_CopiesSlice(t *testing.T) {
+	triangles := randomTriangles(rand.New(rand.NewSource(42)), 10)
+	original := triangles[3]
+	tree := bvh.New(triangles, bvh.SAH, 4)
+
+	moved := bvh.Triangle{A: original.C, B: original.A, C: original.B}
+	tree.SetPrimitive(3, moved)
+	assert.Equal(t, moved, tree.Primitive(3))
+	assert.Equal(t, original, triangles[3])
+
+	triangles[5] = moved
+	assert.NotEqual(t, moved, tree.Primitive(5))
+}
+
+func TestCoincidentPrimitives(t *testing.T) {
+	tri := bvh.Triangle{A: vector3.New(-1., -1., 5.), B: vector3.New(1., -1., 5.), C: vector3.New(0., 1., 5.)}
+	triangles := []bvh.Triangle{tri, tri, tri, tri, tri, tri, tri, tri, tri, tri}
+
+	for name, method := range splitMethods {
+		t.Run(name, func(t *testing.T) {
+			tree := bvh.New(triangles, method, 2)
+			_, distance, hit := tree.ClosestHit(vector3.Zero[float64](), vector3.Forward[float64](), 10, bvh.Triangle.IntersectRay)
+			assert.True(t, hit)
+			assert.InDelta(t, 5., distance, 1e-9)
+			assert.Len(t, tree.Overlapping(tri.Bounds()), len(triangles))
+		})
+	}
+}
+
+func TestUnknownSplitMethod(t *testing.T) {
+	assert.PanicsWithError(t, "unknown split method: 7", func() {
+		bvh.New([]bvh.Triangle{}, bvh.SplitMethod(7), 4)
+	})
+}
+
+var arrLenToTest = []int{
+	1_000,
+	100_000,
+}
+
+var hitResult bool
+
+func BenchmarkClosestHit_BruteForce(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, testLen := range arrLenToTest {
+		triangles := randomTriangles(r, testLen)
+		origin, direction := randomRay(r)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				index, _ := bruteForceClosest(triangles, origin, direction, math.Inf(1))
+				hitResult = index != -1
+			}
+		})
+	}
+}
+
+func BenchmarkClosestHit(b *testing.B) {
+	for name, method := range splitMethods {
+		r := rand.New(rand.NewSource(42))
+		for _, testLen := range arrLenToTest {
+			tree := bvh.New(randomTriangles(r, testLen), method, 4)
+			origin, direction := randomRay(r)
+
+			b.Run(fmt.Sprintf("%s/Len-%d", name, testLen), func(b *testing.B) {
+				for n := 0; n < b.N; n++ {
+					_, _, hitResult = tree.ClosestHit(origin, direction, math.Inf(1), bvh.Triangle.IntersectRay)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkBuild(b *testing.B) {
+	for name, method := range splitMethods {
+		r := rand.New(rand.NewSource(42))
+		for _, testLen := range arrLenToTest {
+			triangles := randomTriangles(r, testLen)
+
+			b.Run(fmt.Sprintf("%s/Len-%d", name, testLen), func(b *testing.B) {
+				for n := 0; n < b.N; n++ {
+					hitResult = bvh.New(triangles, method, 4).Len() > 0
+				}
+			})
+		}
+	}
+}
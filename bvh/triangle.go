@@ -0,0 +1,59 @@
+package bvh
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Triangle is a primitive made up of three points
+type Triangle struct {
+	A, B, C vector3.Float64
+}
+
+// Bounds returns the smallest bounding box containing all three points
+func (t Triangle) Bounds() vector3.AABB[float64] {
+	return vector3.NewAABB(t.A, t.B).Include(t.C)
+}
+
+// Normal returns the unit length normal of the triangle, following the
+// counter clockwise winding of A, B, C
+func (t Triangle) Normal() vector3.Float64 {
+	return t.B.Sub(t.A).Cross(t.C.Sub(t.A)).Normalized()
+}
+
+// IntersectRay determines whether or not the ray intersects the triangle
+// using the Möller–Trumbore algorithm, returning the distance along the ray
+// to the point of intersection. Both sides of the triangle are considered.
+// Its signature matches RayTest, so it can be passed directly to a BVH of
+// triangles as Triangle.IntersectRay.
+func (t Triangle) IntersectRay(origin, direction vector3.Float64) (float64, bool) {
+	const epsilon = 1e-12
+
+	edge1 := t.B.Sub(t.A)
+	edge2 := t.C.Sub(t.A)
+	p := direction.Cross(edge2)
+	det := edge1.Dot(p)
+	if math.Abs(det) < epsilon {
+		return 0, false
+	}
+
+	invDet := 1. / det
+	s := origin.Sub(t.A)
+	u := s.Dot(p) * invDet
+	if u < 0 || u > 1 {
+		return 0, false
+	}
+
+	q := s.Cross(edge1)
+	v := direction.Dot(q) * invDet
+	if v < 0 || u+v > 1 {
+		return 0, false
+	}
+
+	distance := edge2.Dot(q) * invDet
+	if distance < 0 {
+		return 0, false
+	}
+	return distance, true
+}
@@ -0,0 +1,48 @@
+package bvh_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/bvh"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriangle_Bounds(t *testing.T) {
+	tri := bvh.Triangle{A: vector3.New(1., -2., 3.), B: vector3.New(-4., 5., 0.), C: vector3.New(2., 1., -6.)}
+	bounds := tri.Bounds()
+	assert.Equal(t, vector3.New(-4., -2., -6.), bounds.Min())
+	assert.Equal(t, vector3.New(2., 5., 3.), bounds.Max())
+}
+
+func TestTriangle_Normal(t *testing.T) {
+	tri := bvh.Triangle{A: vector3.New(0., 0., 0.), B: vector3.New(1., 0., 0.), C: vector3.New(0., 1., 0.)}
+	assert.Equal(t, vector3.New(0., 0., 1.), tri.Normal())
+}
+
+func TestTriangle_IntersectRay(t *testing.T) {
+	tri := bvh.Triangle{A: vector3.New(-1., -1., 0.), B: vector3.New(1., -1., 0.), C: vector3.New(0., 1., 0.)}
+
+	tests := map[string]struct {
+		origin    vector3.Float64
+		direction vector3.Float64
+		distance  float64
+		hit       bool
+	}{
+		"straight on":      {origin: vector3.New(0., 0., -5.), direction: vector3.New(0., 0., 1.), distance: 5, hit: true},
+		"from behind":      {origin: vector3.New(0., 0., 2.), direction: vector3.New(0., 0., -1.), distance: 2, hit: true},
+		"unnormalized":     {origin: vector3.New(0., 0., -5.), direction: vector3.New(0., 0., 2.), distance: 2.5, hit: true},
+		"pointing away":    {origin: vector3.New(0., 0., -5.), direction: vector3.New(0., 0., -1.), hit: false},
+		"misses to side":   {origin: vector3.New(2., 0., -5.), direction: vector3.New(0., 0., 1.), hit: false},
+		"parallel to face": {origin: vector3.New(-5., 0., 0.), direction: vector3.New(1., 0., 0.), hit: false},
+		"on edge":          {origin: vector3.New(0., -1., -1.), direction: vector3.New(0., 0., 1.), distance: 1, hit: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			distance, hit := tri.IntersectRay(tc.origin, tc.direction)
+			assert.Equal(t, tc.hit, hit)
+			assert.InDelta(t, tc.distance, distance, 1e-9)
+		})
+	}
+}
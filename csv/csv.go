@@ -0,0 +1,397 @@
+// Package csv reads and writes vector arrays as delimited text, covering
+// both CSV files and the whitespace separated XYZ files produced by point
+// cloud tools. Numbers are always parsed and formatted with a '.' decimal
+// separator, regardless of locale.
+package csv
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Format describes the layout of a delimited text file
+type Format struct {
+	// Delimiter separates the columns of a row. The zero value treats any
+	// run of spaces and tabs as a single separator, as found in XYZ files.
+	Delimiter rune
+
+	// Comment, when not zero, marks lines to be skipped when it's the first
+	// non blank character of the line
+	Comment rune
+
+	// Header indicates the first row holds the names of the columns
+	Header bool
+
+	// Columns names the column holding each component of a vector, in X, Y,
+	// Z, W order. When reading they're looked up in the header, and when
+	// writing they're written as the header. Writing a header without
+	// columns provided names them x, y, z, and w. The first column's name
+	// can't start with the Comment rune, as the header would then be skipped
+	// when read back.
+	Columns []string
+
+	// Indices selects the zero based column holding each component of a
+	// vector when reading a file without column names. Without either
+	// Columns or Indices, the leading columns of each row are read.
+	Indices []int
+}
+
+// CSV is a comma separated file whose first row names its columns
+var CSV = Format{Delimiter: ',', Header: true}
+
+// XYZ is a whitespace separated file without a header, with lines starting
+// with # ignored
+var XYZ = Format{Comment: '#'}
+
+// ParseError is returned when a file can not be read, recording the one
+// based line number and column of the row the problem was found in. Column
+// is zero when the error concerns the line as a whole.
+type ParseError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var defaultColumns = []string{"x", "y", "z", "w"}
+
+func (f Format) validate(components int) {
+	if f.Delimiter == '"' || f.Delimiter == '\n' || f.Delimiter == '\r' || f.Delimiter == utf8.RuneError {
+		panic(fmt.Errorf("invalid delimiter: %q", f.Delimiter))
+	}
+	if f.Comment != 0 && f.Comment == f.Delimiter {
+		panic(fmt.Errorf("comment and delimiter can not both be %q", f.Comment))
+	}
+	if len(f.Columns) > 0 && len(f.Indices) > 0 {
+		panic(errors.New("columns and indices can not both be provided"))
+	}
+	if len(f.Columns) > 0 && len(f.Columns) != components {
+		panic(fmt.Errorf("%d columns provided for vectors with %d components", len(f.Columns), components))
+	}
+	if len(f.Indices) > 0 && len(f.Indices) != components {
+		panic(fmt.Errorf("%d indices provided for vectors with %d components", len(f.Indices), components))
+	}
+	for _, index := range f.Indices {
+		if index < 0 {
+			panic(fmt.Errorf("invalid column index: %d", index))
+		}
+	}
+}
+
+// fieldReader splits the lines of a file into fields, tracking the line
+// number and skipping blank and commented lines
+type fieldReader struct {
+	format  Format
+	scanner *bufio.Scanner
+	line    int
+	fields  []string
+}
+
+func newFieldReader(in io.Reader, format Format) *fieldReader {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	return &fieldReader{format: format, scanner: scanner}
+}
+
+// next returns the fields of the next row, or io.EOF once there are none
+func (r *fieldReader) next() ([]string, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := r.scanner.Text()
+		if r.line == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if r.format.Comment != 0 {
+			if c, _ := utf8.DecodeRuneInString(trimmed); c == r.format.Comment {
+				continue
+			}
+		}
+
+		var err error
+		r.fields, err = splitFields(r.fields[:0], line, r.format.Delimiter)
+		if err != nil {
+			return nil, &ParseError{Line: r.line, Column: len(r.fields) + 1, Err: err}
+		}
+		return r.fields, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// splitFields appends the fields of the line to dst. Fields of delimited
+// lines may be surrounded with double quotes, with a pair of double quotes
+// inside representing a single one.
+func splitFields(dst []string, line string, delimiter rune) ([]string, error) {
+	if delimiter == 0 {
+		return append(dst, strings.Fields(line)...), nil
+	}
+
+	for {
+		trimmed := strings.TrimLeft(line, " \t")
+		if !strings.HasPrefix(trimmed, `"`) {
+			i := strings.IndexRune(line, delimiter)
+			if i < 0 {
+				return append(dst, strings.TrimSpace(line)), nil
+			}
+			dst = append(dst, strings.TrimSpace(line[:i]))
+			line = line[i+utf8.RuneLen(delimiter):]
+			continue
+		}
+
+		var field strings.Builder
+		rest := trimmed[1:]
+		for {
+			i := strings.IndexByte(rest, '"')
+			if i < 0 {
+				return dst, errors.New("unterminated quoted field")
+			}
+			field.WriteString(rest[:i])
+			rest = rest[i+1:]
+			if !strings.HasPrefix(rest, `"`) {
+				break
+			}
+			field.WriteByte('"')
+			rest = rest[1:]
+		}
+		rest = strings.TrimLeft(rest, " \t")
+		if rest == "" {
+			return append(dst, field.String()), nil
+		}
+		next, size := utf8.DecodeRuneInString(rest)
+		if next != delimiter {
+			return dst, errors.New("unexpected text after quoted field")
+		}
+		dst = append(dst, field.String())
+		line = rest[size:]
+	}
+}
+
+// columnIndices determines which column of each row holds each component,
+// consuming the header if the format has one
+func (f Format) columnIndices(r *fieldReader, components int) ([]int, error) {
+	var header []string
+	if f.Header {
+		fields, err := r.next()
+		if err == io.EOF {
+			return nil, &ParseError{Line: r.line, Err: errors.New("missing header")}
+		}
+		if err != nil {
+			return nil, err
+		}
+		header = append(header, fields...)
+	}
+
+	switch {
+	case len(f.Columns) > 0:
+		if !f.Header {
+			panic(errors.New("columns can only be selected by name from a header"))
+		}
+		indices := make([]int, len(f.Columns))
+		for i, name := range f.Columns {
+			indices[i] = -1
+			for j, h := range header {
+				if h == name {
+					indices[i] = j
+					break
+				}
+			}
+			if indices[i] < 0 {
+				return nil, &ParseError{Line: r.line, Err: fmt.Errorf("column %q not found in header", name)}
+			}
+		}
+		return indices, nil
+
+	case len(f.Indices) > 0:
+		return f.Indices, nil
+	}
+
+	indices := make([]int, components)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices, nil
+}
+
+// read calls visit with the components of every row of the file
+func (f Format) read(in io.Reader, components int, visit func(values []float64)) error {
+	f.validate(components)
+
+	r := newFieldReader(in, f)
+	indices, err := f.columnIndices(r, components)
+	if err != nil {
+		return err
+	}
+
+	values := make([]float64, components)
+	for {
+		fields, err := r.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, index := range indices {
+			if index >= len(fields) {
+				return &ParseError{
+					Line:   r.line,
+					Column: index + 1,
+					Err:    fmt.Errorf("expected at least %d columns, found %d", index+1, len(fields)),
+				}
+			}
+			v, err := strconv.ParseFloat(fields[index], 64)
+			if err != nil {
+				return &ParseError{
+					Line:   r.line,
+					Column: index + 1,
+					Err:    fmt.Errorf("invalid number: %q", fields[index]),
+				}
+			}
+			values[i] = v
+		}
+		visit(values)
+	}
+}
+
+// ReadVector2 reads a 2D vector from every row of the file
+func (f Format) ReadVector2(in io.Reader) (vector2.Float64Array, error) {
+	var out vector2.Float64Array
+	err := f.read(in, 2, func(values []float64) {
+		out = append(out, vector2.New(values[0], values[1]))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadVector3 reads a 3D vector from every row of the file
+func (f Format) ReadVector3(in io.Reader) (vector3.Float64Array, error) {
+	var out vector3.Float64Array
+	err := f.read(in, 3, func(values []float64) {
+		out = append(out, vector3.New(values[0], values[1], values[2]))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadVector4 reads a 4D vector from every row of the file
+func (f Format) ReadVector4(in io.Reader) (vector4.Float64Array, error) {
+	var out vector4.Float64Array
+	err := f.read(in, 4, func(values []float64) {
+		out = append(out, vector4.New(values[0], values[1], values[2], values[3]))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// appendField appends the field to the row, quoting it if it contains
+// anything that would otherwise be misread
+func appendField(buf []byte, field string, delimiter rune) []byte {
+	needsQuotes := strings.ContainsAny(field, "\"\r\n") ||
+		strings.ContainsRune(field, delimiter) ||
+		strings.TrimSpace(field) != field
+	if !needsQuotes {
+		return append(buf, field...)
+	}
+	buf = append(buf, '"')
+	buf = append(buf, strings.ReplaceAll(field, `"`, `""`)...)
+	return append(buf, '"')
+}
+
+// write writes a row for each of the count vectors, with component
+// returning the component of a vector
+func (f Format) write(out io.Writer, components, count int, component func(i, c int) float64) error {
+	f.validate(components)
+	if f.Delimiter == 0 {
+		f.Delimiter = ' '
+	}
+
+	bw := bufio.NewWriter(out)
+	var buf []byte
+	if f.Header {
+		columns := f.Columns
+		if len(columns) == 0 {
+			columns = defaultColumns[:components]
+		}
+		for i, name := range columns {
+			if f.Delimiter == ' ' && (name == "" || strings.ContainsAny(name, " \t")) {
+				return fmt.Errorf("column %q can not be written to a whitespace separated file", name)
+			}
+			if i == 0 && f.Comment != 0 && strings.HasPrefix(name, string(f.Comment)) {
+				return fmt.Errorf("column %q would be read back as a comment", name)
+			}
+			if i > 0 {
+				buf = utf8.AppendRune(buf, f.Delimiter)
+			}
+			buf = appendField(buf, name, f.Delimiter)
+		}
+		buf = append(buf, '\n')
+		bw.Write(buf)
+	}
+
+	for i := range count {
+		buf = buf[:0]
+		for c := range components {
+			if c > 0 {
+				buf = utf8.AppendRune(buf, f.Delimiter)
+			}
+			buf = strconv.AppendFloat(buf, component(i, c), 'g', -1, 64)
+		}
+		buf = append(buf, '\n')
+		bw.Write(buf)
+	}
+	return bw.Flush()
+}
+
+// WriteVector2 writes a row for every vector in the array
+func (f Format) WriteVector2(out io.Writer, arr vector2.Float64Array) error {
+	return f.write(out, 2, len(arr), func(i, c int) float64 {
+		return arr[i].Component(c)
+	})
+}
+
+// WriteVector3 writes a row for every vector in the array
+func (f Format) WriteVector3(out io.Writer, arr vector3.Float64Array) error {
+	return f.write(out, 3, len(arr), func(i, c int) float64 {
+		return arr[i].Component(c)
+	})
+}
+
+// WriteVector4 writes a row for every vector in the array
+func (f Format) WriteVector4(out io.Writer, arr vector4.Float64Array) error {
+	return f.write(out, 4, len(arr), func(i, c int) float64 {
+		return arr[i].Component(c)
+	})
+}
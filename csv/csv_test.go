@@ -0,0 +1,264 @@
+package csv_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/csv"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadVector3(t *testing.T) {
+	tests := map[string]struct {
+		format csv.Format
+		input  string
+		want   vector3.Float64Array
+	}{
+		"csv": {
+			format: csv.CSV,
+			input:  "x,y,z\n1,2,3\n4.5,-5e2,6\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(4.5, -500., 6.)},
+		},
+		"xyz": {
+			format: csv.XYZ,
+			input:  "# exported points\n1 2 3\n\n  4\t5    6 128 255 0\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)},
+		},
+		"named columns": {
+			format: csv.Format{Delimiter: ',', Header: true, Columns: []string{"east", "north", "height"}},
+			input:  "id,height,north,east\n7,3,2,1\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.)},
+		},
+		"column indices": {
+			format: csv.Format{Delimiter: ';', Indices: []int{2, 0, 1}},
+			input:  "2;3;1\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.)},
+		},
+		"quoted fields": {
+			format: csv.Format{Delimiter: ',', Header: true, Columns: []string{"a, b", `say "hi"`, "c"}},
+			input:  "c,\"a, b\" , \"say \"\"hi\"\"\"\r\n\"3\",1,2\r\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.)},
+		},
+		"byte order mark": {
+			format: csv.CSV,
+			input:  "\uFEFFx,y,z\n1,2,3\n",
+			want:   vector3.Float64Array{vector3.New(1., 2., 3.)},
+		},
+		"header only": {
+			format: csv.CSV,
+			input:  "x,y,z\n",
+			want:   nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			arr, err := tc.format.ReadVector3(strings.NewReader(tc.input))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, arr)
+		})
+	}
+}
+
+func TestReadVector3_Errors(t *testing.T) {
+	tests := map[string]struct {
+		format csv.Format
+		input  string
+		line   int
+		column int
+		err    string
+	}{
+		"locale decimal comma": {
+			format: csv.Format{Delimiter: ';'},
+			input:  "1;2;3\n1,5;2;3\n",
+			line:   2,
+			column: 1,
+			err:    `line 2, column 1: invalid number: "1,5"`,
+		},
+		"missing column": {
+			format: csv.XYZ,
+			input:  "# comment\n1 2 3\n4 5\n",
+			line:   3,
+			column: 3,
+			err:    "line 3, column 3: expected at least 3 columns, found 2",
+		},
+		"missing header": {
+			format: csv.CSV,
+			input:  "",
+			line:   0,
+			column: 0,
+			err:    "line 0: missing header",
+		},
+		"unknown column": {
+			format: csv.Format{Delimiter: ',', Header: true, Columns: []string{"x", "y", "height"}},
+			input:  "\nx,y,z\n",
+			line:   2,
+			column: 0,
+			err:    `line 2: column "height" not found in header`,
+		},
+		"unterminated quote": {
+			format: csv.CSV,
+			input:  "x,y,z\n1,\"2,3\n",
+			line:   2,
+			column: 2,
+			err:    "line 2, column 2: unterminated quoted field",
+		},
+		"text after quote": {
+			format: csv.CSV,
+			input:  "x,y,z\n1,\"2\"3,4\n",
+			line:   2,
+			column: 2,
+			err:    "line 2, column 2: unexpected text after quoted field",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			arr, err := tc.format.ReadVector3(strings.NewReader(tc.input))
+			assert.Nil(t, arr)
+			assert.EqualError(t, err, tc.err)
+
+			var parseErr *csv.ParseError
+			if assert.True(t, errors.As(err, &parseErr)) {
+				assert.Equal(t, tc.line, parseErr.Line)
+				assert.Equal(t, tc.column, parseErr.Column)
+			}
+		})
+	}
+}
+
+func TestFormat_InvalidPanics(t *testing.T) {
+	tests := map[string]struct {
+		format csv.Format
+		err    string
+	}{
+		"quote delimiter": {
+			format: csv.Format{Delimiter: '"'},
+			err:    `invalid delimiter: '"'`,
+		},
+		"comment is delimiter": {
+			format: csv.Format{Delimiter: ',', Comment: ','},
+			err:    "comment and delimiter can not both be ','",
+		},
+		"columns and indices": {
+			format: csv.Format{Header: true, Columns: []string{"a", "b", "c"}, Indices: []int{0, 1, 2}},
+			err:    "columns and indices can not both be provided",
+		},
+		"column count": {
+			format: csv.Format{Header: true, Columns: []string{"a", "b"}},
+			err:    "2 columns provided for vectors with 3 components",
+		},
+		"index count": {
+			format: csv.Format{Indices: []int{0, 1, 2, 3}},
+			err:    "4 indices provided for vectors with 3 components",
+		},
+		"negative index": {
+			format: csv.Format{Indices: []int{0, -1, 2}},
+			err:    "invalid column index: -1",
+		},
+		"columns without header": {
+			format: csv.Format{Columns: []string{"a", "b", "c"}},
+			err:    "columns can only be selected by name from a header",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.PanicsWithError(t, tc.err, func() {
+				tc.format.ReadVector3(strings.NewReader("1 2 3\n"))
+			})
+		})
+	}
+}
+
+func TestWriteVector3(t *testing.T) {
+	arr := vector3.Float64Array{
+		vector3.New(1., 2.5, -3.),
+		vector3.New(1e21, 0.1, math.SmallestNonzeroFloat64),
+	}
+
+	tests := map[string]struct {
+		format csv.Format
+		want   string
+	}{
+		"csv": {
+			format: csv.CSV,
+			want:   "x,y,z\n1,2.5,-3\n1e+21,0.1,5e-324\n",
+		},
+		"xyz": {
+			format: csv.XYZ,
+			want:   "1 2.5 -3\n1e+21 0.1 5e-324\n",
+		},
+		"quoted columns": {
+			format: csv.Format{Delimiter: ',', Header: true, Columns: []string{"a,b", ` c`, `"d"`}},
+			want:   "\"a,b\",\" c\",\"\"\"d\"\"\"\n1,2.5,-3\n1e+21,0.1,5e-324\n",
+		},
+		"tabs": {
+			format: csv.Format{Delimiter: '\t', Header: true, Columns: []string{"east", "north", "up"}},
+			want:   "east\tnorth\tup\n1\t2.5\t-3\n1e+21\t0.1\t5e-324\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			assert.NoError(t, tc.format.WriteVector3(&buf, arr))
+			assert.Equal(t, tc.want, buf.String())
+
+			back, err := tc.format.ReadVector3(&buf)
+			assert.NoError(t, err)
+			assert.Equal(t, arr, back)
+		})
+	}
+}
+
+func TestWriteVector3_WhitespaceColumnName(t *testing.T) {
+	format := csv.Format{Header: true, Columns: []string{"x", "y", "z value"}}
+	err := format.WriteVector3(&bytes.Buffer{}, vector3.Float64Array{vector3.Zero[float64]()})
+	assert.EqualError(t, err, `column "z value" can not be written to a whitespace separated file`)
+}
+
+func TestWriteVector3_CommentColumnName(t *testing.T) {
+	format := csv.Format{Delimiter: ',', Comment: '#', Header: true, Columns: []string{"#x", "y", "z"}}
+	err := format.WriteVector3(&bytes.Buffer{}, vector3.Float64Array{vector3.Zero[float64]()})
+	assert.EqualError(t, err, `column "#x" would be read back as a comment`)
+
+	// Only the start of the line is mistaken for a comment
+	format.Columns = []string{"x", "#y", "z"}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, format.WriteVector3(buf, vector3.Float64Array{vector3.One[float64]()}))
+
+	back, err := format.ReadVector3(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.Float64Array{vector3.One[float64]()}, back)
+}
+
+func TestReadWriteVector2(t *testing.T) {
+	arr := vector2.Float64Array{vector2.New(1., 2.), vector2.New(-0.5, 1e-9)}
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, csv.CSV.WriteVector2(&buf, arr))
+	assert.Equal(t, "x,y\n1,2\n-0.5,1e-09\n", buf.String())
+
+	back, err := csv.CSV.ReadVector2(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+}
+
+func TestReadWriteVector4(t *testing.T) {
+	arr := vector4.Float64Array{vector4.New(1., 2., 3., 4.), vector4.New(-0.5, 1e-9, 0., 255.)}
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, csv.XYZ.WriteVector4(&buf, arr))
+	assert.Equal(t, "1 2 3 4\n-0.5 1e-09 0 255\n", buf.String())
+
+	back, err := csv.XYZ.ReadVector4(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+}
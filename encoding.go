@@ -0,0 +1,207 @@
+package vector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Encoding determines how individual vector components are stored when
+// written in a compact binary form
+type Encoding int
+
+const (
+	// Half stores components as IEEE 754 half precision (binary16) floats,
+	// rounding to the nearest representable value with ties to even. Values
+	// too large to represent become infinities.
+	Half Encoding = iota
+
+	// SNORM8 maps components within [-1, 1] onto signed 8 bit integers.
+	// Components outside the range are clamped.
+	SNORM8
+
+	// SNORM16 maps components within [-1, 1] onto signed 16 bit integers.
+	// Components outside the range are clamped.
+	SNORM16
+
+	// UNORM8 maps components within [0, 1] onto unsigned 8 bit integers.
+	// Components outside the range are clamped.
+	UNORM8
+
+	// UNORM16 maps components within [0, 1] onto unsigned 16 bit integers.
+	// Components outside the range are clamped.
+	UNORM16
+)
+
+// Size returns the number of bytes a single component occupies
+func (e Encoding) Size() int {
+	switch e {
+	case SNORM8, UNORM8:
+		return 1
+	case Half, SNORM16, UNORM16:
+		return 2
+	}
+	panic(fmt.Errorf("unknown encoding: %d", e))
+}
+
+// Put encodes the component into the start of dst, which must be at least
+// Size bytes long
+func (e Encoding) Put(dst []byte, endian binary.ByteOrder, component float64) {
+	switch e {
+	case Half:
+		endian.PutUint16(dst, EncodeHalf(component))
+	case SNORM8:
+		dst[0] = byte(EncodeSNORM8(component))
+	case SNORM16:
+		endian.PutUint16(dst, uint16(EncodeSNORM16(component)))
+	case UNORM8:
+		dst[0] = EncodeUNORM8(component)
+	case UNORM16:
+		endian.PutUint16(dst, EncodeUNORM16(component))
+	default:
+		panic(fmt.Errorf("unknown encoding: %d", e))
+	}
+}
+
+// Get decodes a component from the start of src, which must be at least Size
+// bytes long
+func (e Encoding) Get(src []byte, endian binary.ByteOrder) float64 {
+	switch e {
+	case Half:
+		return DecodeHalf(endian.Uint16(src))
+	case SNORM8:
+		return DecodeSNORM8(int8(src[0]))
+	case SNORM16:
+		return DecodeSNORM16(int16(endian.Uint16(src)))
+	case UNORM8:
+		return DecodeUNORM8(src[0])
+	case UNORM16:
+		return DecodeUNORM16(endian.Uint16(src))
+	}
+	panic(fmt.Errorf("unknown encoding: %d", e))
+}
+
+// roundToEven shifts the significand right, rounding the bits shifted out to
+// the nearest value with ties to even
+func roundToEven(significand uint64, shift uint) uint64 {
+	out := significand >> shift
+	remainder := significand & (1<<shift - 1)
+	halfway := uint64(1) << (shift - 1)
+	if remainder > halfway || (remainder == halfway && out&1 == 1) {
+		out++
+	}
+	return out
+}
+
+// EncodeHalf converts the value to the bits of the nearest IEEE 754 half
+// precision float, with ties rounding to even. The conversion is made
+// directly from the float64 to avoid double rounding.
+func EncodeHalf(f float64) uint16 {
+	bits := math.Float64bits(f)
+	sign := uint16(bits>>48) & 0x8000
+	exponent := int(bits>>52) & 0x7ff
+	mantissa := bits & (1<<52 - 1)
+
+	if exponent == 0x7ff {
+		if mantissa != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	unbiased := exponent - 1023
+	switch {
+	case unbiased > 15:
+		return sign | 0x7c00
+
+	case unbiased >= -14:
+		// Rounding may carry into the exponent, which the addition handles,
+		// including carrying all the way to infinity
+		h := uint64(unbiased+15)<<10 + roundToEven(mantissa, 42)
+		if h >= 0x7c00 {
+			return sign | 0x7c00
+		}
+		return sign | uint16(h)
+
+	case unbiased >= -25:
+		// Subnormal, counted in units of 2^-24. A carry out of the mantissa
+		// produces the smallest normal number.
+		significand := 1<<52 | mantissa
+		return sign | uint16(roundToEven(significand, uint(28-unbiased)))
+	}
+
+	return sign
+}
+
+// DecodeHalf converts the bits of an IEEE 754 half precision float to a
+// float64. Every half precision value is exactly representable.
+func DecodeHalf(h uint16) float64 {
+	sign := 1.
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exponent := int(h>>10) & 0x1f
+	mantissa := float64(h & 0x3ff)
+
+	switch exponent {
+	case 0:
+		return sign * math.Ldexp(mantissa, -24)
+	case 0x1f:
+		if mantissa != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(1024+mantissa, exponent-25)
+}
+
+// encodeNormalized scales the value clamped to [lo, 1] by scale, rounding to
+// the nearest integer. NaN encodes as 0.
+func encodeNormalized(f, lo, scale float64) float64 {
+	if math.IsNaN(f) {
+		return 0
+	}
+	return math.Round(Clamp(f, lo, 1) * scale)
+}
+
+// EncodeSNORM8 maps the value within [-1, 1] onto [-127, 127]
+func EncodeSNORM8(f float64) int8 {
+	return int8(encodeNormalized(f, -1, math.MaxInt8))
+}
+
+// DecodeSNORM8 maps the value within [-127, 127] onto [-1, 1]. -128 also
+// decodes to -1.
+func DecodeSNORM8(v int8) float64 {
+	return max(float64(v)/math.MaxInt8, -1)
+}
+
+// EncodeSNORM16 maps the value within [-1, 1] onto [-32767, 32767]
+func EncodeSNORM16(f float64) int16 {
+	return int16(encodeNormalized(f, -1, math.MaxInt16))
+}
+
+// DecodeSNORM16 maps the value within [-32767, 32767] onto [-1, 1]. -32768
+// also decodes to -1.
+func DecodeSNORM16(v int16) float64 {
+	return max(float64(v)/math.MaxInt16, -1)
+}
+
+// EncodeUNORM8 maps the value within [0, 1] onto [0, 255]
+func EncodeUNORM8(f float64) uint8 {
+	return uint8(encodeNormalized(f, 0, math.MaxUint8))
+}
+
+// DecodeUNORM8 maps the value within [0, 255] onto [0, 1]
+func DecodeUNORM8(v uint8) float64 {
+	return float64(v) / math.MaxUint8
+}
+
+// EncodeUNORM16 maps the value within [0, 1] onto [0, 65535]
+func EncodeUNORM16(f float64) uint16 {
+	return uint16(encodeNormalized(f, 0, math.MaxUint16))
+}
+
+// DecodeUNORM16 maps the value within [0, 65535] onto [0, 1]
+func DecodeUNORM16(v uint16) float64 {
+	return float64(v) / math.MaxUint16
+}
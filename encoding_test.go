@@ -0,0 +1,148 @@
+package vector_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeHalf(t *testing.T) {
+	tests := map[string]struct {
+		in   float64
+		want uint16
+	}{
+		"zero":                     {in: 0, want: 0x0000},
+		"negative zero":            {in: math.Copysign(0, -1), want: 0x8000},
+		"one":                      {in: 1, want: 0x3c00},
+		"negative two":             {in: -2, want: 0xc000},
+		"third":                    {in: 1. / 3., want: 0x3555},
+		"largest":                  {in: 65504, want: 0x7bff},
+		"rounds down to largest":   {in: 65519, want: 0x7bff},
+		"rounds up to infinity":    {in: 65520, want: 0x7c00},
+		"overflow":                 {in: 1e10, want: 0x7c00},
+		"negative overflow":        {in: -1e10, want: 0xfc00},
+		"infinity":                 {in: math.Inf(1), want: 0x7c00},
+		"negative infinity":        {in: math.Inf(-1), want: 0xfc00},
+		"tie rounds to even down":  {in: 1 + math.Ldexp(1, -11), want: 0x3c00},
+		"tie rounds to even up":    {in: 1 + 3*math.Ldexp(1, -11), want: 0x3c02},
+		"above tie rounds up":      {in: 1 + math.Ldexp(1, -11) + math.Ldexp(1, -30), want: 0x3c01},
+		"smallest normal":          {in: math.Ldexp(1, -14), want: 0x0400},
+		"largest subnormal":        {in: math.Ldexp(1023, -24), want: 0x03ff},
+		"subnormal carries normal": {in: math.Ldexp(1023.5, -24), want: 0x0400},
+		"smallest subnormal":       {in: math.Ldexp(1, -24), want: 0x0001},
+		"tie to zero":              {in: math.Ldexp(1, -25), want: 0x0000},
+		"above tie to smallest":    {in: math.Ldexp(3, -26), want: 0x0001},
+		"underflow":                {in: 1e-20, want: 0x0000},
+		"negative underflow":       {in: -1e-20, want: 0x8000},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, vector.EncodeHalf(tc.in))
+		})
+	}
+}
+
+func TestEncodeHalf_NaN(t *testing.T) {
+	h := vector.EncodeHalf(math.NaN())
+	assert.Equal(t, uint16(0x7c00), h&0x7c00)
+	assert.NotZero(t, h&0x03ff)
+	assert.True(t, math.IsNaN(vector.DecodeHalf(h)))
+}
+
+func TestHalf_RoundTripsEveryValue(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := uint16(i)
+		f := vector.DecodeHalf(h)
+		if math.IsNaN(f) {
+			continue
+		}
+		if !assert.Equal(t, h, vector.EncodeHalf(f), "half %#04x decoded as %g", h, f) {
+			return
+		}
+
+		// Matches the float32 conversion, which is exact for halves
+		if !assert.Equal(t, f, float64(float32(f))) {
+			return
+		}
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	assert.Equal(t, int8(127), vector.EncodeSNORM8(1))
+	assert.Equal(t, int8(-127), vector.EncodeSNORM8(-1))
+	assert.Equal(t, int8(-127), vector.EncodeSNORM8(-5))
+	assert.Equal(t, int8(64), vector.EncodeSNORM8(0.5))
+	assert.Equal(t, int8(0), vector.EncodeSNORM8(math.NaN()))
+	assert.Equal(t, -1., vector.DecodeSNORM8(-128))
+	assert.Equal(t, -1., vector.DecodeSNORM8(-127))
+
+	assert.Equal(t, int16(32767), vector.EncodeSNORM16(2))
+	assert.Equal(t, int16(-16384), vector.EncodeSNORM16(-0.5))
+	assert.Equal(t, -1., vector.DecodeSNORM16(-32768))
+
+	assert.Equal(t, uint8(255), vector.EncodeUNORM8(1))
+	assert.Equal(t, uint8(0), vector.EncodeUNORM8(-1))
+	assert.Equal(t, uint8(128), vector.EncodeUNORM8(0.5))
+	assert.Equal(t, 1., vector.DecodeUNORM8(255))
+
+	assert.Equal(t, uint16(65535), vector.EncodeUNORM16(3))
+	assert.Equal(t, uint16(32768), vector.EncodeUNORM16(0.5))
+	assert.Equal(t, 0., vector.DecodeUNORM16(0))
+}
+
+func TestNormalized_RoundTripsEveryValue(t *testing.T) {
+	for i := math.MinInt8 + 1; i <= math.MaxInt8; i++ {
+		assert.Equal(t, int8(i), vector.EncodeSNORM8(vector.DecodeSNORM8(int8(i))))
+	}
+	for i := math.MinInt16 + 1; i <= math.MaxInt16; i++ {
+		assert.Equal(t, int16(i), vector.EncodeSNORM16(vector.DecodeSNORM16(int16(i))))
+	}
+	for i := 0; i <= math.MaxUint8; i++ {
+		assert.Equal(t, uint8(i), vector.EncodeUNORM8(vector.DecodeUNORM8(uint8(i))))
+	}
+	for i := 0; i <= math.MaxUint16; i++ {
+		assert.Equal(t, uint16(i), vector.EncodeUNORM16(vector.DecodeUNORM16(uint16(i))))
+	}
+}
+
+func TestEncoding_PutGet(t *testing.T) {
+	tests := map[string]struct {
+		encoding vector.Encoding
+		in       float64
+		bytes    []byte
+		out      float64
+	}{
+		"half":    {encoding: vector.Half, in: -2, bytes: []byte{0xc0, 0x00}, out: -2},
+		"snorm8":  {encoding: vector.SNORM8, in: -1, bytes: []byte{0x81}, out: -1},
+		"snorm16": {encoding: vector.SNORM16, in: 1, bytes: []byte{0x7f, 0xff}, out: 1},
+		"unorm8":  {encoding: vector.UNORM8, in: 0.2, bytes: []byte{51}, out: 0.2},
+		"unorm16": {encoding: vector.UNORM16, in: 1, bytes: []byte{0xff, 0xff}, out: 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, len(tc.bytes), tc.encoding.Size())
+
+			buf := make([]byte, tc.encoding.Size())
+			tc.encoding.Put(buf, binary.BigEndian, tc.in)
+			assert.Equal(t, tc.bytes, buf)
+			assert.Equal(t, tc.out, tc.encoding.Get(buf, binary.BigEndian))
+		})
+	}
+}
+
+func TestEncoding_Unknown(t *testing.T) {
+	assert.PanicsWithError(t, "unknown encoding: 99", func() {
+		vector.Encoding(99).Size()
+	})
+	assert.PanicsWithError(t, "unknown encoding: 99", func() {
+		vector.Encoding(99).Put(make([]byte, 2), binary.BigEndian, 1)
+	})
+	assert.PanicsWithError(t, "unknown encoding: 99", func() {
+		vector.Encoding(99).Get(make([]byte, 2), binary.BigEndian)
+	})
+}
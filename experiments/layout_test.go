@@ -0,0 +1,144 @@
+package experiments_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+var layoutResultVector vector3.Float64
+var layoutResultArray vector3.Float64Array
+var layoutResultSoA vector3.Float64SoA
+
+func layoutTestData(testLen int) vector3.Float64Array {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector3.Float64Array, testLen)
+	for i := range arr {
+		arr[i] = vector3.RandRange(r, -100., 100.)
+	}
+	return arr
+}
+
+func BenchmarkLayoutAddInplace_Array(b *testing.B) {
+	add := vector3.New(1., 2., 3.)
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultArray = arr.AddInplace(add)
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutAddInplace_SoA(b *testing.B) {
+	add := vector3.New(1., 2., 3.)
+	for _, testLen := range arrLenToTest {
+		soa := layoutTestData(testLen).ToSoA()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultSoA = soa.AddInplace(add)
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutScaleInplace_Array(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultArray = arr.ScaleInplace(1.0001)
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutScaleInplace_SoA(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		soa := layoutTestData(testLen).ToSoA()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultSoA = soa.ScaleInplace(1.0001)
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutSum_Array(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultVector = arr.Sum()
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutSum_SoA(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		soa := layoutTestData(testLen).ToSoA()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultVector = soa.Sum()
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutBounds_Array(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultVector, _ = arr.Bounds()
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutBounds_SoA(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		soa := layoutTestData(testLen).ToSoA()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultVector, _ = soa.Bounds()
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutNormalized_Array(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultArray = arr.Normalized()
+			}
+		})
+	}
+}
+
+func BenchmarkLayoutNormalized_SoA(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		soa := layoutTestData(testLen).ToSoA()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				layoutResultSoA = soa.Normalized()
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package experiments_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+var parallelResultVector vector3.Float64
+var parallelResultArray vector3.Float64Array
+
+func BenchmarkParallelSum_Sequential(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultVector = arr.Sum()
+			}
+		})
+	}
+}
+
+func BenchmarkParallelSum_Parallel(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen).Parallel(0)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultVector = arr.Sum()
+			}
+		})
+	}
+}
+
+func BenchmarkParallelScaleInplace_Sequential(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultArray = arr.ScaleInplace(1.0001)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelScaleInplace_Parallel(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen).Parallel(0)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultArray = arr.ScaleInplace(1.0001)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelStandardDeviation_Sequential(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, parallelResultVector = arr.StandardDeviation()
+			}
+		})
+	}
+}
+
+func BenchmarkParallelStandardDeviation_Parallel(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen).Parallel(0)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, parallelResultVector = arr.StandardDeviation()
+			}
+		})
+	}
+}
+
+func BenchmarkParallelModify_Sequential(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultArray = arr.Modify(vector3.Float64.Normalized)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelModify_Parallel(b *testing.B) {
+	for _, testLen := range arrLenToTest {
+		arr := layoutTestData(testLen).Parallel(0)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				parallelResultArray = arr.Modify(vector3.Float64.Normalized)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package geodesy
+
+import (
+	"errors"
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// MeanEarthRadius is the mean radius of the earth in meters, as defined by
+// the IUGG
+const MeanEarthRadius = 6371008.8
+
+// ErrVincentyNoConvergence is returned when Vincenty's formula fails to
+// converge, which happens for nearly antipodal points
+var ErrVincentyNoConvergence = errors.New("vincenty formula failed to converge")
+
+// Haversine computes the great-circle distance in meters between two
+// (latitude, longitude) coordinates, treating the earth as a sphere. It's
+// fast, but can be off by up to 0.5% compared to Vincenty.
+func Haversine(a, b vector2.Float64) float64 {
+	lat1 := toRadians(a.X())
+	lat2 := toRadians(b.X())
+	dLat := lat2 - lat1
+	dLon := toRadians(b.Y() - a.Y())
+
+	sinLat := math.Sin(dLat / 2)
+	sinLon := math.Sin(dLon / 2)
+	h := sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLon*sinLon
+
+	return 2 * MeanEarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Vincenty computes the geodesic distance in meters between two
+// (latitude, longitude) coordinates on the WGS84 ellipsoid, accurate to
+// within a millimeter. ErrVincentyNoConvergence is returned for nearly
+// antipodal points.
+//
+// https://en.wikipedia.org/wiki/Vincenty%27s_formulae
+func Vincenty(a, b vector2.Float64) (float64, error) {
+	const maxIterations = 200
+
+	l := toRadians(b.Y() - a.Y())
+	u1 := math.Atan((1 - Flattening) * math.Tan(toRadians(a.X())))
+	u2 := math.Atan((1 - Flattening) * math.Tan(toRadians(b.X())))
+	sinU1, cosU1 := math.Sin(u1), math.Cos(u1)
+	sinU2, cosU2 := math.Sin(u2), math.Cos(u2)
+
+	lambda := l
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+
+	converged := false
+	for i := 0; i < maxIterations; i++ {
+		sinLambda, cosLambda := math.Sin(lambda), math.Cos(lambda)
+
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			// Coincident points
+			return 0, nil
+		}
+
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+
+		// Both points on the equator
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+
+		c := Flattening / 16 * cosSqAlpha * (4 + Flattening*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = l + (1-c)*Flattening*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-previous) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		return math.NaN(), ErrVincentyNoConvergence
+	}
+
+	uSq := cosSqAlpha * (SemiMajorAxis*SemiMajorAxis - SemiMinorAxis*SemiMinorAxis) / (SemiMinorAxis * SemiMinorAxis)
+	bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	return SemiMinorAxis * bigA * (sigma - deltaSigma), nil
+}
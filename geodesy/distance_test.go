@@ -0,0 +1,94 @@
+package geodesy_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/geodesy"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func dms(degrees, minutes, seconds float64) float64 {
+	sign := math.Copysign(1, degrees)
+	return sign * (math.Abs(degrees) + minutes/60 + seconds/3600)
+}
+
+func TestHaversine(t *testing.T) {
+	tests := map[string]struct {
+		a, b  vector2.Float64
+		want  float64
+		delta float64
+	}{
+		"same point": {
+			a: vector2.New(10., 20.), b: vector2.New(10., 20.),
+			want: 0, delta: 1e-9,
+		},
+		"quarter of the equator": {
+			a: vector2.New(0., 0.), b: vector2.New(0., 90.),
+			want: math.Pi / 2 * geodesy.MeanEarthRadius, delta: 1e-6,
+		},
+		"pole to pole": {
+			a: vector2.New(90., 0.), b: vector2.New(-90., 0.),
+			want: math.Pi * geodesy.MeanEarthRadius, delta: 1e-6,
+		},
+		"london to paris": {
+			a: vector2.New(51.5074, -0.1278), b: vector2.New(48.8566, 2.3522),
+			want: 343_560, delta: 500,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, geodesy.Haversine(tc.a, tc.b), tc.delta)
+			assert.InDelta(t, tc.want, geodesy.Haversine(tc.b, tc.a), tc.delta)
+		})
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	tests := map[string]struct {
+		a, b vector2.Float64
+		want float64
+	}{
+		"same point": {
+			a: vector2.New(10., 20.), b: vector2.New(10., 20.),
+			want: 0,
+		},
+		"flinders peak to buninyong": {
+			a:    vector2.New(dms(-37, 57, 3.72030), dms(144, 25, 29.52440)),
+			b:    vector2.New(dms(-37, 39, 10.15610), dms(143, 55, 35.38390)),
+			want: 54972.271,
+		},
+		"along the equator": {
+			a: vector2.New(0., 0.), b: vector2.New(0., 1.),
+			want: geodesy.SemiMajorAxis * math.Pi / 180,
+		},
+		"meridian quarter": {
+			a: vector2.New(0., 0.), b: vector2.New(90., 0.),
+			want: 10001965.729,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := geodesy.Vincenty(tc.a, tc.b)
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.want, got, 0.001)
+		})
+	}
+}
+
+func TestVincenty_Antipodal(t *testing.T) {
+	_, err := geodesy.Vincenty(vector2.New(0., 0.), vector2.New(0.5, 179.7))
+	assert.ErrorIs(t, err, geodesy.ErrVincentyNoConvergence)
+}
+
+func TestHaversineCloseToVincenty(t *testing.T) {
+	a := vector2.New(40.7128, -74.0060)
+	b := vector2.New(34.0522, -118.2437)
+
+	vincenty, err := geodesy.Vincenty(a, b)
+	assert.NoError(t, err)
+	assert.InDelta(t, vincenty, geodesy.Haversine(a, b), vincenty*0.005)
+}
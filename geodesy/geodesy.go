@@ -0,0 +1,176 @@
+// Package geodesy converts between WGS84 geodetic coordinates, earth-centered
+// earth-fixed (ECEF) coordinates, and local east-north-up (ENU) and
+// north-east-down (NED) tangent planes.
+//
+// Geodetic coordinates are stored as vector3.Float64 values laid out as
+// (latitude, longitude, altitude), with latitude and longitude in degrees and
+// altitude in meters above the WGS84 ellipsoid. Functions that only deal with
+// positions on the surface take vector2.Float64 values laid out as
+// (latitude, longitude). All cartesian coordinates are in meters.
+package geodesy
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// WGS84 ellipsoid parameters
+const (
+	// SemiMajorAxis is the equatorial radius of the WGS84 ellipsoid in meters
+	SemiMajorAxis = 6378137.0
+
+	// Flattening of the WGS84 ellipsoid
+	Flattening = 1 / 298.257223563
+
+	// SemiMinorAxis is the polar radius of the WGS84 ellipsoid in meters
+	SemiMinorAxis = SemiMajorAxis * (1 - Flattening)
+
+	// eccentricitySquared is the first eccentricity squared
+	eccentricitySquared = Flattening * (2 - Flattening)
+)
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// primeVerticalRadius is the radius of curvature in the prime vertical at the
+// latitude provided in radians
+func primeVerticalRadius(latitude float64) float64 {
+	sin := math.Sin(latitude)
+	return SemiMajorAxis / math.Sqrt(1-eccentricitySquared*sin*sin)
+}
+
+// GeodeticToECEF converts a (latitude, longitude, altitude) coordinate into
+// earth-centered earth-fixed cartesian coordinates
+func GeodeticToECEF(geodetic vector3.Float64) vector3.Float64 {
+	lat := toRadians(geodetic.X())
+	lon := toRadians(geodetic.Y())
+	alt := geodetic.Z()
+
+	n := primeVerticalRadius(lat)
+	cosLat := math.Cos(lat)
+
+	return vector3.New(
+		(n+alt)*cosLat*math.Cos(lon),
+		(n+alt)*cosLat*math.Sin(lon),
+		(n*(1-eccentricitySquared)+alt)*math.Sin(lat),
+	)
+}
+
+// ECEFToGeodetic converts earth-centered earth-fixed cartesian coordinates
+// into a (latitude, longitude, altitude) coordinate
+func ECEFToGeodetic(ecef vector3.Float64) vector3.Float64 {
+	x, y, z := ecef.Values()
+	p := math.Hypot(x, y)
+	lon := math.Atan2(y, x)
+
+	// Iterate on latitude, starting from the geocentric approximation. This
+	// converges to sub-millimeter precision within a handful of iterations
+	lat := math.Atan2(z, p*(1-eccentricitySquared))
+	for i := 0; i < 10; i++ {
+		n := primeVerticalRadius(lat)
+		next := math.Atan2(z+eccentricitySquared*n*math.Sin(lat), p)
+		done := math.Abs(next-lat) < 1e-15
+		lat = next
+		if done {
+			break
+		}
+	}
+
+	// Valid everywhere, including the poles where cos(lat) approaches 0
+	n := primeVerticalRadius(lat)
+	alt := p*math.Cos(lat) + z*math.Sin(lat) - (SemiMajorAxis*SemiMajorAxis)/n
+
+	return vector3.New(toDegrees(lat), toDegrees(lon), alt)
+}
+
+// ECEFToENU converts earth-centered earth-fixed coordinates into a local
+// east-north-up tangent plane centered on the geodetic origin provided
+func ECEFToENU(ecef, origin vector3.Float64) vector3.Float64 {
+	lat := toRadians(origin.X())
+	lon := toRadians(origin.Y())
+	sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+	sinLon, cosLon := math.Sin(lon), math.Cos(lon)
+
+	dx, dy, dz := ecef.Sub(GeodeticToECEF(origin)).Values()
+
+	return vector3.New(
+		-sinLon*dx+cosLon*dy,
+		-sinLat*cosLon*dx-sinLat*sinLon*dy+cosLat*dz,
+		cosLat*cosLon*dx+cosLat*sinLon*dy+sinLat*dz,
+	)
+}
+
+// ENUToECEF converts coordinates in a local east-north-up tangent plane
+// centered on the geodetic origin provided into earth-centered earth-fixed
+// coordinates
+func ENUToECEF(enu, origin vector3.Float64) vector3.Float64 {
+	lat := toRadians(origin.X())
+	lon := toRadians(origin.Y())
+	sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+	sinLon, cosLon := math.Sin(lon), math.Cos(lon)
+
+	e, n, u := enu.Values()
+
+	return GeodeticToECEF(origin).Add(vector3.New(
+		-sinLon*e-sinLat*cosLon*n+cosLat*cosLon*u,
+		cosLon*e-sinLat*sinLon*n+cosLat*sinLon*u,
+		cosLat*n+sinLat*u,
+	))
+}
+
+// GeodeticToENU converts a (latitude, longitude, altitude) coordinate into a
+// local east-north-up tangent plane centered on the geodetic origin provided
+func GeodeticToENU(geodetic, origin vector3.Float64) vector3.Float64 {
+	return ECEFToENU(GeodeticToECEF(geodetic), origin)
+}
+
+// ENUToGeodetic converts coordinates in a local east-north-up tangent plane
+// centered on the geodetic origin provided into a
+// (latitude, longitude, altitude) coordinate
+func ENUToGeodetic(enu, origin vector3.Float64) vector3.Float64 {
+	return ECEFToGeodetic(ENUToECEF(enu, origin))
+}
+
+// ENUToNED converts east-north-up coordinates into north-east-down
+// coordinates of the same tangent plane
+func ENUToNED(enu vector3.Float64) vector3.Float64 {
+	return vector3.New(enu.Y(), enu.X(), -enu.Z())
+}
+
+// NEDToENU converts north-east-down coordinates into east-north-up
+// coordinates of the same tangent plane
+func NEDToENU(ned vector3.Float64) vector3.Float64 {
+	return vector3.New(ned.Y(), ned.X(), -ned.Z())
+}
+
+// ECEFToNED converts earth-centered earth-fixed coordinates into a local
+// north-east-down tangent plane centered on the geodetic origin provided
+func ECEFToNED(ecef, origin vector3.Float64) vector3.Float64 {
+	return ENUToNED(ECEFToENU(ecef, origin))
+}
+
+// NEDToECEF converts coordinates in a local north-east-down tangent plane
+// centered on the geodetic origin provided into earth-centered earth-fixed
+// coordinates
+func NEDToECEF(ned, origin vector3.Float64) vector3.Float64 {
+	return ENUToECEF(NEDToENU(ned), origin)
+}
+
+// GeodeticToNED converts a (latitude, longitude, altitude) coordinate into a
+// local north-east-down tangent plane centered on the geodetic origin provided
+func GeodeticToNED(geodetic, origin vector3.Float64) vector3.Float64 {
+	return ENUToNED(GeodeticToENU(geodetic, origin))
+}
+
+// NEDToGeodetic converts coordinates in a local north-east-down tangent plane
+// centered on the geodetic origin provided into a
+// (latitude, longitude, altitude) coordinate
+func NEDToGeodetic(ned, origin vector3.Float64) vector3.Float64 {
+	return ENUToGeodetic(NEDToENU(ned), origin)
+}
@@ -0,0 +1,128 @@
+package geodesy_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/geodesy"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeodeticToECEF(t *testing.T) {
+	tests := map[string]struct {
+		geodetic vector3.Float64
+		ecef     vector3.Float64
+	}{
+		"origin": {
+			geodetic: vector3.New(0., 0., 0.),
+			ecef:     vector3.New(geodesy.SemiMajorAxis, 0., 0.),
+		},
+		"east": {
+			geodetic: vector3.New(0., 90., 100.),
+			ecef:     vector3.New(0., geodesy.SemiMajorAxis+100, 0.),
+		},
+		"north pole": {
+			geodetic: vector3.New(90., 0., 0.),
+			ecef:     vector3.New(0., 0., geodesy.SemiMinorAxis),
+		},
+		"south pole": {
+			geodetic: vector3.New(-90., 45., 10.),
+			ecef:     vector3.New(0., 0., -geodesy.SemiMinorAxis-10),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ecef := geodesy.GeodeticToECEF(tc.geodetic)
+			assert.InDelta(t, tc.ecef.X(), ecef.X(), 0.1)
+			assert.InDelta(t, tc.ecef.Y(), ecef.Y(), 0.1)
+			assert.InDelta(t, tc.ecef.Z(), ecef.Z(), 0.1)
+
+			back := geodesy.ECEFToGeodetic(ecef)
+			assert.InDelta(t, tc.geodetic.X(), back.X(), 1e-9)
+			assert.InDelta(t, tc.geodetic.Z(), back.Z(), 1e-6)
+		})
+	}
+}
+
+func TestECEFRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		geodetic := vector3.New(
+			r.Float64()*180-90,
+			r.Float64()*360-180,
+			r.Float64()*20000-1000,
+		)
+		back := geodesy.ECEFToGeodetic(geodesy.GeodeticToECEF(geodetic))
+		assert.InDelta(t, geodetic.X(), back.X(), 1e-9)
+		assert.InDelta(t, geodetic.Y(), back.Y(), 1e-9)
+		assert.InDelta(t, geodetic.Z(), back.Z(), 1e-6)
+	}
+}
+
+func TestENU(t *testing.T) {
+	origin := vector3.New(37.7749, -122.4194, 10.)
+
+	// Straight up from the origin
+	up := geodesy.GeodeticToENU(vector3.New(37.7749, -122.4194, 110.), origin)
+	assert.InDelta(t, 0, up.X(), 1e-6)
+	assert.InDelta(t, 0, up.Y(), 1e-6)
+	assert.InDelta(t, 100, up.Z(), 1e-6)
+
+	// Slightly north of the origin
+	north := geodesy.GeodeticToENU(vector3.New(37.7759, -122.4194, 10.), origin)
+	assert.InDelta(t, 0, north.X(), 1e-6)
+	assert.InDelta(t, 111., north.Y(), 1)
+	assert.Less(t, north.Z(), 0.)
+
+	// Slightly east of the origin
+	east := geodesy.GeodeticToENU(vector3.New(37.7749, -122.4184, 10.), origin)
+	assert.InDelta(t, 88., east.X(), 1)
+	assert.InDelta(t, 0, east.Y(), 1e-3)
+}
+
+func TestENURoundTrip(t *testing.T) {
+	origin := vector3.New(-33.8688, 151.2093, 58.)
+
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		enu := vector3.RandRange(r, -5000., 5000.)
+
+		geodetic := geodesy.ENUToGeodetic(enu, origin)
+		back := geodesy.GeodeticToENU(geodetic, origin)
+		assert.InDelta(t, enu.X(), back.X(), 1e-6)
+		assert.InDelta(t, enu.Y(), back.Y(), 1e-6)
+		assert.InDelta(t, enu.Z(), back.Z(), 1e-6)
+
+		ecef := geodesy.ENUToECEF(enu, origin)
+		back = geodesy.ECEFToENU(ecef, origin)
+		assert.InDelta(t, enu.X(), back.X(), 1e-6)
+		assert.InDelta(t, enu.Y(), back.Y(), 1e-6)
+		assert.InDelta(t, enu.Z(), back.Z(), 1e-6)
+	}
+}
+
+func TestNED(t *testing.T) {
+	origin := vector3.New(48.8566, 2.3522, 35.)
+	point := vector3.New(48.8576, 2.3532, 5.)
+
+	enu := geodesy.GeodeticToENU(point, origin)
+	ned := geodesy.GeodeticToNED(point, origin)
+	assert.InDelta(t, enu.Y(), ned.X(), 1e-9)
+	assert.InDelta(t, enu.X(), ned.Y(), 1e-9)
+	assert.InDelta(t, -enu.Z(), ned.Z(), 1e-9)
+
+	back := geodesy.NEDToGeodetic(ned, origin)
+	assert.InDelta(t, point.X(), back.X(), 1e-9)
+	assert.InDelta(t, point.Y(), back.Y(), 1e-9)
+	assert.InDelta(t, point.Z(), back.Z(), 1e-6)
+
+	ecef := geodesy.NEDToECEF(ned, origin)
+	backNED := geodesy.ECEFToNED(ecef, origin)
+	assert.InDelta(t, ned.X(), backNED.X(), 1e-6)
+	assert.InDelta(t, ned.Y(), backNED.Y(), 1e-6)
+	assert.InDelta(t, ned.Z(), backNED.Z(), 1e-6)
+
+	assert.Equal(t, enu, geodesy.NEDToENU(geodesy.ENUToNED(enu)))
+}
@@ -0,0 +1,170 @@
+package geometry
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+func geoJSONPosition(l Layout, v vector3.Float64) []float64 {
+	if l == XYZ {
+		return []float64{v.X(), v.Y(), v.Z()}
+	}
+	return []float64{v.X(), v.Y()}
+}
+
+func geoJSONPositions(l Layout, arr vector3.Float64Array) [][]float64 {
+	out := make([][]float64, len(arr))
+	for i, v := range arr {
+		out[i] = geoJSONPosition(l, v)
+	}
+	return out
+}
+
+func geoJSONPositionLists(l Layout, arrs []vector3.Float64Array) [][][]float64 {
+	out := make([][][]float64, len(arrs))
+	for i, arr := range arrs {
+		out[i] = geoJSONPositions(l, arr)
+	}
+	return out
+}
+
+// MarshalGeoJSON encodes the geometry as a GeoJSON geometry object. An empty
+// point is written with no coordinates.
+func MarshalGeoJSON(g Geometry) ([]byte, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	var coordinates any
+	switch g := g.(type) {
+	case Point:
+		if g.Empty() {
+			coordinates = []float64{}
+		} else {
+			coordinates = geoJSONPosition(g.Layout, g.Coordinates)
+		}
+
+	case LineString:
+		coordinates = geoJSONPositions(g.Layout, g.Coordinates)
+
+	case Polygon:
+		coordinates = geoJSONPositionLists(g.Layout, g.Rings)
+
+	case MultiPoint:
+		coordinates = geoJSONPositions(g.Layout, g.Points)
+
+	case MultiLineString:
+		coordinates = geoJSONPositionLists(g.Layout, g.LineStrings)
+
+	case MultiPolygon:
+		polygons := make([][][][]float64, len(g.Polygons))
+		for i, rings := range g.Polygons {
+			polygons[i] = geoJSONPositionLists(g.Layout, rings)
+		}
+		coordinates = polygons
+	}
+
+	return json.Marshal(struct {
+		Type        string `json:"type"`
+		Coordinates any    `json:"coordinates"`
+	}{
+		Type:        g.Type(),
+		Coordinates: coordinates,
+	})
+}
+
+// UnmarshalGeoJSON decodes a GeoJSON geometry object. Positions with three
+// values produce XYZ geometries, and every position of the geometry must
+// have the same number of values. GeoJSON has no way of recording the layout
+// of a geometry without any positions, so empty geometries always decode as
+// XY.
+func UnmarshalGeoJSON(data []byte) (Geometry, error) {
+	var raw geoJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	switch raw.Type {
+	case "Point", "LineString", "Polygon", "MultiPoint", "MultiLineString", "MultiPolygon":
+	default:
+		return nil, fmt.Errorf("unsupported geojson geometry type: %q", raw.Type)
+	}
+	if raw.Coordinates == nil || string(raw.Coordinates) == "null" {
+		return nil, fmt.Errorf("geojson %s is missing coordinates", raw.Type)
+	}
+
+	g, err := decodeGeoJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("geojson %s: %w", raw.Type, err)
+	}
+	if err := g.validate(); err != nil {
+		return nil, fmt.Errorf("geojson %s: %w", raw.Type, err)
+	}
+	return g, nil
+}
+
+func decodeGeoJSON(raw geoJSON) (Geometry, error) {
+	tracker := layoutTracker{}
+	switch raw.Type {
+	case "Point":
+		var position []float64
+		if err := json.Unmarshal(raw.Coordinates, &position); err != nil {
+			return nil, err
+		}
+		if len(position) == 0 {
+			return Point{Coordinates: emptyPoint}, nil
+		}
+		v, err := tracker.vector(position)
+		return Point{Layout: tracker.layout(), Coordinates: v}, err
+
+	case "LineString", "MultiPoint":
+		var positions [][]float64
+		if err := json.Unmarshal(raw.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		arr, err := tracker.array(positions)
+		if err != nil {
+			return nil, err
+		}
+		if raw.Type == "MultiPoint" {
+			return MultiPoint{Layout: tracker.layout(), Points: arr}, nil
+		}
+		return LineString{Layout: tracker.layout(), Coordinates: arr}, nil
+
+	case "Polygon", "MultiLineString":
+		var lists [][][]float64
+		if err := json.Unmarshal(raw.Coordinates, &lists); err != nil {
+			return nil, err
+		}
+		arrs, err := tracker.arrays(lists)
+		if err != nil {
+			return nil, err
+		}
+		if raw.Type == "MultiLineString" {
+			return MultiLineString{Layout: tracker.layout(), LineStrings: arrs}, nil
+		}
+		return Polygon{Layout: tracker.layout(), Rings: arrs}, nil
+
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err := json.Unmarshal(raw.Coordinates, &polygons); err != nil {
+			return nil, err
+		}
+		out := make([][]vector3.Float64Array, len(polygons))
+		for i, lists := range polygons {
+			arrs, err := tracker.arrays(lists)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = arrs
+		}
+		return MultiPolygon{Layout: tracker.layout(), Polygons: out}, nil
+	}
+	return nil, fmt.Errorf("unsupported geojson geometry type: %q", raw.Type)
+}
@@ -0,0 +1,111 @@
+package geometry_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/geometry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoJSON(t *testing.T) {
+	for name, tc := range encodingCases {
+		t.Run(name, func(t *testing.T) {
+			data, err := geometry.MarshalGeoJSON(tc.geometry)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.geoJSON, string(data))
+
+			back, err := geometry.UnmarshalGeoJSON(data)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.geometry, back)
+		})
+	}
+}
+
+func TestMarshalGeoJSON_Invalid(t *testing.T) {
+	for name, tc := range invalidGeometries {
+		t.Run(name, func(t *testing.T) {
+			data, err := geometry.MarshalGeoJSON(tc.geometry)
+			assert.Nil(t, data)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestMarshalGeoJSON_EmptyPoint(t *testing.T) {
+	g, err := geometry.UnmarshalWKT("POINT EMPTY")
+	assert.NoError(t, err)
+
+	data, err := geometry.MarshalGeoJSON(g)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Point","coordinates":[]}`, string(data))
+
+	back, err := geometry.UnmarshalGeoJSON(data)
+	assert.NoError(t, err)
+	assert.True(t, back.(geometry.Point).Empty())
+}
+
+func TestGeoJSON_EmptyLayout(t *testing.T) {
+	for _, wkt := range []string{"POINT Z EMPTY", "LINESTRING Z EMPTY", "MULTIPOLYGON Z EMPTY"} {
+		g, err := geometry.UnmarshalWKT(wkt)
+		assert.NoError(t, err)
+
+		data, err := geometry.MarshalGeoJSON(g)
+		assert.NoError(t, err)
+
+		// Without any positions, GeoJSON can't record the layout
+		back, err := geometry.UnmarshalGeoJSON(data)
+		assert.NoError(t, err)
+		back2D, err := geometry.MarshalWKT(back)
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Replace(wkt, " Z", "", 1), back2D)
+	}
+}
+
+func TestUnmarshalGeoJSON_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"unsupported type": {
+			input: `{"type":"GeometryCollection","geometries":[]}`,
+			err:   `unsupported geojson geometry type: "GeometryCollection"`,
+		},
+		"missing coordinates": {
+			input: `{"type":"Point"}`,
+			err:   "geojson Point is missing coordinates",
+		},
+		"null coordinates": {
+			input: `{"type":"Point","coordinates":null}`,
+			err:   "geojson Point is missing coordinates",
+		},
+		"mixed dimensions": {
+			input: `{"type":"LineString","coordinates":[[0,0],[1,1,1]]}`,
+			err:   "geojson LineString: position has 3 values, expected 2",
+		},
+		"too many values": {
+			input: `{"type":"Point","coordinates":[1,2,3,4]}`,
+			err:   "geojson Point: position has 4 values, expected 2 or 3",
+		},
+		"open ring": {
+			input: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`,
+			err:   "geojson Polygon: ring 0 is not closed",
+		},
+		"wrong nesting": {
+			input: `{"type":"Polygon","coordinates":[[0,0],[1,0]]}`,
+			err:   "geojson Polygon: json: cannot unmarshal number",
+		},
+		"invalid json": {
+			input: `{"type":`,
+			err:   "unexpected end of JSON input",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.UnmarshalGeoJSON([]byte(tc.input))
+			assert.Nil(t, g)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Package geometry converts vectors to and from the Points, LineStrings,
+// Polygons, and their Multi counterparts exchanged by GIS software, encoded
+// as GeoJSON, WKT, or WKB.
+//
+// Coordinates are stored as 3D vectors, with X holding the longitude or
+// easting and Y the latitude or northing. A geometry's Layout records whether
+// or not Z holds an elevation. Z is ignored when encoding XY geometries, and
+// left as zero when decoding them.
+package geometry
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Layout is the set of dimensions held by a geometry's coordinates
+type Layout int
+
+const (
+	XY Layout = iota
+	XYZ
+)
+
+func (l Layout) dimensions() int {
+	if l == XYZ {
+		return 3
+	}
+	return 2
+}
+
+func (l Layout) validate() error {
+	if l != XY && l != XYZ {
+		return fmt.Errorf("unknown layout: %d", l)
+	}
+	return nil
+}
+
+// Geometry is one of Point, LineString, Polygon, MultiPoint, MultiLineString,
+// or MultiPolygon
+type Geometry interface {
+	// Type returns the name of the geometry, as used by GeoJSON
+	Type() string
+
+	layout() Layout
+	validate() error
+}
+
+// Point is a single position. A point with NaN coordinates is empty.
+type Point struct {
+	Layout      Layout
+	Coordinates vector3.Float64
+}
+
+// LineString is a path through two or more positions
+type LineString struct {
+	Layout      Layout
+	Coordinates vector3.Float64Array
+}
+
+// Polygon is an area bounded by its first ring, with each ring after it
+// being a hole. Every ring must be closed, starting and ending with the same
+// position, and hold at least four positions.
+type Polygon struct {
+	Layout Layout
+	Rings  []vector3.Float64Array
+}
+
+// MultiPoint is a collection of points
+type MultiPoint struct {
+	Layout Layout
+	Points vector3.Float64Array
+}
+
+// MultiLineString is a collection of line strings
+type MultiLineString struct {
+	Layout      Layout
+	LineStrings []vector3.Float64Array
+}
+
+// MultiPolygon is a collection of polygons, each being a list of rings
+// following the same rules as Polygon's
+type MultiPolygon struct {
+	Layout   Layout
+	Polygons [][]vector3.Float64Array
+}
+
+func (Point) Type() string           { return "Point" }
+func (LineString) Type() string      { return "LineString" }
+func (Polygon) Type() string         { return "Polygon" }
+func (MultiPoint) Type() string      { return "MultiPoint" }
+func (MultiLineString) Type() string { return "MultiLineString" }
+func (MultiPolygon) Type() string    { return "MultiPolygon" }
+
+func (p Point) layout() Layout             { return p.Layout }
+func (ls LineString) layout() Layout       { return ls.Layout }
+func (p Polygon) layout() Layout           { return p.Layout }
+func (mp MultiPoint) layout() Layout       { return mp.Layout }
+func (mls MultiLineString) layout() Layout { return mls.Layout }
+func (mp MultiPolygon) layout() Layout     { return mp.Layout }
+
+// Empty determines whether or not the point has no position
+func (p Point) Empty() bool {
+	return math.IsNaN(p.Coordinates.X()) && math.IsNaN(p.Coordinates.Y())
+}
+
+// emptyPoint is the position used to represent a point without one
+var emptyPoint = vector3.New(math.NaN(), math.NaN(), math.NaN())
+
+func validateLineString(line vector3.Float64Array) error {
+	if len(line) == 1 {
+		return fmt.Errorf("line string has a single position, at least 2 are required")
+	}
+	return nil
+}
+
+func validateRings(l Layout, rings []vector3.Float64Array) error {
+	for i, ring := range rings {
+		if len(ring) < 4 {
+			return fmt.Errorf("ring %d has %d positions, at least 4 are required", i, len(ring))
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if l == XY {
+			first, last = first.SetZ(0), last.SetZ(0)
+		}
+		if first != last {
+			return fmt.Errorf("ring %d is not closed", i)
+		}
+	}
+	return nil
+}
+
+func (p Point) validate() error {
+	return p.Layout.validate()
+}
+
+func (ls LineString) validate() error {
+	if err := ls.Layout.validate(); err != nil {
+		return err
+	}
+	return validateLineString(ls.Coordinates)
+}
+
+func (p Polygon) validate() error {
+	if err := p.Layout.validate(); err != nil {
+		return err
+	}
+	return validateRings(p.Layout, p.Rings)
+}
+
+func (mp MultiPoint) validate() error {
+	return mp.Layout.validate()
+}
+
+func (mls MultiLineString) validate() error {
+	if err := mls.Layout.validate(); err != nil {
+		return err
+	}
+	for i, line := range mls.LineStrings {
+		if err := validateLineString(line); err != nil {
+			return fmt.Errorf("line string %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (mp MultiPolygon) validate() error {
+	if err := mp.Layout.validate(); err != nil {
+		return err
+	}
+	for i, rings := range mp.Polygons {
+		if err := validateRings(mp.Layout, rings); err != nil {
+			return fmt.Errorf("polygon %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// To3D converts 2D positions into XY layout coordinates
+func To3D(arr vector2.Float64Array) vector3.Float64Array {
+	out := make(vector3.Float64Array, len(arr))
+	for i, v := range arr {
+		out[i] = vector3.New(v.X(), v.Y(), 0)
+	}
+	return out
+}
+
+// To2D drops the Z component of the coordinates
+func To2D(arr vector3.Float64Array) vector2.Float64Array {
+	out := make(vector2.Float64Array, len(arr))
+	for i, v := range arr {
+		out[i] = v.XY()
+	}
+	return out
+}
+
+func ringsTo3D(rings []vector2.Float64Array) []vector3.Float64Array {
+	out := make([]vector3.Float64Array, len(rings))
+	for i, ring := range rings {
+		out[i] = To3D(ring)
+	}
+	return out
+}
+
+// Point2D creates an XY point
+func Point2D(v vector2.Float64) Point {
+	return Point{Layout: XY, Coordinates: vector3.New(v.X(), v.Y(), 0)}
+}
+
+// LineString2D creates an XY line string
+func LineString2D(line vector2.Float64Array) LineString {
+	return LineString{Layout: XY, Coordinates: To3D(line)}
+}
+
+// Polygon2D creates an XY polygon from its exterior ring followed by its
+// holes
+func Polygon2D(rings ...vector2.Float64Array) Polygon {
+	return Polygon{Layout: XY, Rings: ringsTo3D(rings)}
+}
+
+// MultiPoint2D creates an XY multi point
+func MultiPoint2D(points vector2.Float64Array) MultiPoint {
+	return MultiPoint{Layout: XY, Points: To3D(points)}
+}
+
+// MultiLineString2D creates an XY multi line string
+func MultiLineString2D(lines ...vector2.Float64Array) MultiLineString {
+	return MultiLineString{Layout: XY, LineStrings: ringsTo3D(lines)}
+}
+
+// MultiPolygon2D creates an XY multi polygon, with each polygon being its
+// exterior ring followed by its holes
+func MultiPolygon2D(polygons ...[]vector2.Float64Array) MultiPolygon {
+	out := make([][]vector3.Float64Array, len(polygons))
+	for i, rings := range polygons {
+		out[i] = ringsTo3D(rings)
+	}
+	return MultiPolygon{Layout: XY, Polygons: out}
+}
+
+// layoutTracker converts the positions of a decoded geometry into vectors,
+// requiring every position to share the number of dimensions of the first
+type layoutTracker struct {
+	dimensions int
+}
+
+func (t *layoutTracker) layout() Layout {
+	if t.dimensions == 3 {
+		return XYZ
+	}
+	return XY
+}
+
+func (t *layoutTracker) vector(position []float64) (vector3.Float64, error) {
+	if len(position) < 2 || len(position) > 3 {
+		return vector3.Float64{}, fmt.Errorf("position has %d values, expected 2 or 3", len(position))
+	}
+	if t.dimensions == 0 {
+		t.dimensions = len(position)
+	}
+	if len(position) != t.dimensions {
+		return vector3.Float64{}, fmt.Errorf("position has %d values, expected %d", len(position), t.dimensions)
+	}
+	if len(position) == 2 {
+		return vector3.New(position[0], position[1], 0), nil
+	}
+	return vector3.New(position[0], position[1], position[2]), nil
+}
+
+func (t *layoutTracker) array(positions [][]float64) (vector3.Float64Array, error) {
+	out := make(vector3.Float64Array, len(positions))
+	for i, p := range positions {
+		v, err := t.vector(p)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (t *layoutTracker) arrays(lists [][][]float64) ([]vector3.Float64Array, error) {
+	out := make([]vector3.Float64Array, len(lists))
+	for i, positions := range lists {
+		arr, err := t.array(positions)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = arr
+	}
+	return out, nil
+}
@@ -0,0 +1,143 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/geometry"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func square(min, max float64) vector2.Float64Array {
+	return vector2.Float64Array{
+		vector2.New(min, min),
+		vector2.New(max, min),
+		vector2.New(max, max),
+		vector2.New(min, max),
+		vector2.New(min, min),
+	}
+}
+
+// encodingCases are geometries alongside their expected GeoJSON and WKT
+var encodingCases = map[string]struct {
+	geometry geometry.Geometry
+	geoJSON  string
+	wkt      string
+}{
+	"point": {
+		geometry: geometry.Point2D(vector2.New(-122.5, 37.75)),
+		geoJSON:  `{"type":"Point","coordinates":[-122.5,37.75]}`,
+		wkt:      "POINT (-122.5 37.75)",
+	},
+	"point z": {
+		geometry: geometry.Point{Layout: geometry.XYZ, Coordinates: vector3.New(1., 2., 3.)},
+		geoJSON:  `{"type":"Point","coordinates":[1,2,3]}`,
+		wkt:      "POINT Z (1 2 3)",
+	},
+	"line string": {
+		geometry: geometry.LineString2D(vector2.Float64Array{vector2.New(0., 0.), vector2.New(1., 0.5)}),
+		geoJSON:  `{"type":"LineString","coordinates":[[0,0],[1,0.5]]}`,
+		wkt:      "LINESTRING (0 0, 1 0.5)",
+	},
+	"empty line string": {
+		geometry: geometry.LineString{Coordinates: vector3.Float64Array{}},
+		geoJSON:  `{"type":"LineString","coordinates":[]}`,
+		wkt:      "LINESTRING EMPTY",
+	},
+	"polygon with hole": {
+		geometry: geometry.Polygon2D(square(0, 10), square(4, 6)),
+		geoJSON:  `{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,10],[0,0]],[[4,4],[6,4],[6,6],[4,6],[4,4]]]}`,
+		wkt:      "POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (4 4, 6 4, 6 6, 4 6, 4 4))",
+	},
+	"multi point z": {
+		geometry: geometry.MultiPoint{
+			Layout: geometry.XYZ,
+			Points: vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.)},
+		},
+		geoJSON: `{"type":"MultiPoint","coordinates":[[1,2,3],[4,5,6]]}`,
+		wkt:     "MULTIPOINT Z ((1 2 3), (4 5 6))",
+	},
+	"multi line string": {
+		geometry: geometry.MultiLineString2D(
+			vector2.Float64Array{vector2.New(0., 0.), vector2.New(1., 1.)},
+			vector2.Float64Array{vector2.New(2., 2.), vector2.New(3., 3.), vector2.New(4., 2.)},
+		),
+		geoJSON: `{"type":"MultiLineString","coordinates":[[[0,0],[1,1]],[[2,2],[3,3],[4,2]]]}`,
+		wkt:     "MULTILINESTRING ((0 0, 1 1), (2 2, 3 3, 4 2))",
+	},
+	"multi polygon with holes": {
+		geometry: geometry.MultiPolygon2D(
+			[]vector2.Float64Array{square(0, 10), square(4, 6)},
+			[]vector2.Float64Array{square(20, 30)},
+		),
+		geoJSON: `{"type":"MultiPolygon","coordinates":[` +
+			`[[[0,0],[10,0],[10,10],[0,10],[0,0]],[[4,4],[6,4],[6,6],[4,6],[4,4]]],` +
+			`[[[20,20],[30,20],[30,30],[20,30],[20,20]]]]}`,
+		wkt: "MULTIPOLYGON (((0 0, 10 0, 10 10, 0 10, 0 0), (4 4, 6 4, 6 6, 4 6, 4 4)), " +
+			"((20 20, 30 20, 30 30, 20 30, 20 20)))",
+	},
+	"empty multi polygon": {
+		geometry: geometry.MultiPolygon{Polygons: [][]vector3.Float64Array{}},
+		geoJSON:  `{"type":"MultiPolygon","coordinates":[]}`,
+		wkt:      "MULTIPOLYGON EMPTY",
+	},
+}
+
+// invalidGeometries fail validation, and can't be encoded in any format
+var invalidGeometries = map[string]struct {
+	geometry geometry.Geometry
+	err      string
+}{
+	"unknown layout": {
+		geometry: geometry.Point{Layout: 7},
+		err:      "unknown layout: 7",
+	},
+	"single position line string": {
+		geometry: geometry.LineString2D(vector2.Float64Array{vector2.New(1., 2.)}),
+		err:      "line string has a single position, at least 2 are required",
+	},
+	"short ring": {
+		geometry: geometry.Polygon2D(vector2.Float64Array{vector2.New(0., 0.), vector2.New(1., 0.), vector2.New(0., 0.)}),
+		err:      "ring 0 has 3 positions, at least 4 are required",
+	},
+	"open ring": {
+		geometry: geometry.Polygon2D(square(0, 10)[:4]),
+		err:      "ring 0 is not closed",
+	},
+	"open hole": {
+		geometry: geometry.MultiPolygon2D([]vector2.Float64Array{square(0, 10), square(4, 6)[1:]}),
+		err:      "polygon 0: ring 1 is not closed",
+	},
+	"multi line string": {
+		geometry: geometry.MultiLineString2D(vector2.Float64Array{vector2.New(1., 2.)}),
+		err:      "line string 0: line string has a single position, at least 2 are required",
+	},
+}
+
+func TestPoint_Empty(t *testing.T) {
+	assert.False(t, geometry.Point{}.Empty())
+	assert.False(t, geometry.Point2D(vector2.New(1., 2.)).Empty())
+
+	g, err := geometry.UnmarshalWKT("POINT EMPTY")
+	assert.NoError(t, err)
+	assert.True(t, g.(geometry.Point).Empty())
+}
+
+func TestTo2D(t *testing.T) {
+	arr := vector2.Float64Array{vector2.New(1., 2.), vector2.New(3., 4.)}
+	lifted := geometry.To3D(arr)
+	assert.Equal(t, vector3.Float64Array{vector3.New(1., 2., 0.), vector3.New(3., 4., 0.)}, lifted)
+	assert.Equal(t, arr, geometry.To2D(lifted))
+}
+
+func TestRingClosure_IgnoresZForXY(t *testing.T) {
+	ring := geometry.To3D(square(0, 1))
+	ring[0] = ring[0].SetZ(5)
+
+	_, err := geometry.MarshalWKT(geometry.Polygon{Layout: geometry.XY, Rings: []vector3.Float64Array{ring}})
+	assert.NoError(t, err)
+
+	_, err = geometry.MarshalWKT(geometry.Polygon{Layout: geometry.XYZ, Rings: []vector3.Float64Array{ring}})
+	assert.EqualError(t, err, "ring 0 is not closed")
+}
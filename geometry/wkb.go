@@ -0,0 +1,363 @@
+package geometry
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+const (
+	wkbPoint uint32 = iota + 1
+	wkbLineString
+	wkbPolygon
+	wkbMultiPoint
+	wkbMultiLineString
+	wkbMultiPolygon
+)
+
+// Flags set on the type of extended WKB geometries, as written by PostGIS
+const (
+	ewkbZ    uint32 = 0x80000000
+	ewkbM    uint32 = 0x40000000
+	ewkbSRID uint32 = 0x20000000
+)
+
+var wkbTypeNames = map[uint32]string{
+	wkbPoint:           "Point",
+	wkbLineString:      "LineString",
+	wkbPolygon:         "Polygon",
+	wkbMultiPoint:      "MultiPoint",
+	wkbMultiLineString: "MultiLineString",
+	wkbMultiPolygon:    "MultiPolygon",
+}
+
+type wkbWriter struct {
+	endian binary.AppendByteOrder
+	layout Layout
+	buf    []byte
+}
+
+func (w *wkbWriter) header(geometryType uint32) {
+	if w.endian == binary.LittleEndian {
+		w.buf = append(w.buf, 1)
+	} else {
+		w.buf = append(w.buf, 0)
+	}
+	if w.layout == XYZ {
+		geometryType += 1000
+	}
+	w.buf = w.endian.AppendUint32(w.buf, geometryType)
+}
+
+func (w *wkbWriter) position(v vector3.Float64) {
+	w.buf = w.endian.AppendUint64(w.buf, math.Float64bits(v.X()))
+	w.buf = w.endian.AppendUint64(w.buf, math.Float64bits(v.Y()))
+	if w.layout == XYZ {
+		w.buf = w.endian.AppendUint64(w.buf, math.Float64bits(v.Z()))
+	}
+}
+
+func (w *wkbWriter) positions(arr vector3.Float64Array) {
+	w.buf = w.endian.AppendUint32(w.buf, uint32(len(arr)))
+	for _, v := range arr {
+		w.position(v)
+	}
+}
+
+func (w *wkbWriter) rings(rings []vector3.Float64Array) {
+	w.buf = w.endian.AppendUint32(w.buf, uint32(len(rings)))
+	for _, ring := range rings {
+		w.positions(ring)
+	}
+}
+
+// MarshalWKB encodes the geometry as ISO Well-Known Binary with the byte
+// order provided, which must be either binary.LittleEndian or
+// binary.BigEndian. Empty points are written with NaN coordinates.
+func MarshalWKB(g Geometry, endian binary.ByteOrder) ([]byte, error) {
+	var order binary.AppendByteOrder
+	switch endian {
+	case binary.LittleEndian:
+		order = binary.LittleEndian
+	case binary.BigEndian:
+		order = binary.BigEndian
+	default:
+		panic(fmt.Errorf("unsupported byte order: %v", endian))
+	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	w := wkbWriter{endian: order, layout: g.layout()}
+	switch g := g.(type) {
+	case Point:
+		w.header(wkbPoint)
+		w.position(g.Coordinates)
+
+	case LineString:
+		w.header(wkbLineString)
+		w.positions(g.Coordinates)
+
+	case Polygon:
+		w.header(wkbPolygon)
+		w.rings(g.Rings)
+
+	case MultiPoint:
+		w.header(wkbMultiPoint)
+		w.buf = w.endian.AppendUint32(w.buf, uint32(len(g.Points)))
+		for _, v := range g.Points {
+			w.header(wkbPoint)
+			w.position(v)
+		}
+
+	case MultiLineString:
+		w.header(wkbMultiLineString)
+		w.buf = w.endian.AppendUint32(w.buf, uint32(len(g.LineStrings)))
+		for _, line := range g.LineStrings {
+			w.header(wkbLineString)
+			w.positions(line)
+		}
+
+	case MultiPolygon:
+		w.header(wkbMultiPolygon)
+		w.buf = w.endian.AppendUint32(w.buf, uint32(len(g.Polygons)))
+		for _, rings := range g.Polygons {
+			w.header(wkbPolygon)
+			w.rings(rings)
+		}
+	}
+	return w.buf, nil
+}
+
+type wkbReader struct {
+	data   []byte
+	offset int
+	endian binary.ByteOrder
+	layout Layout
+}
+
+func (r *wkbReader) errorf(format string, a ...any) error {
+	return fmt.Errorf("wkb offset %d: %w", r.offset, fmt.Errorf(format, a...))
+}
+
+func (r *wkbReader) uint32() (uint32, error) {
+	if len(r.data)-r.offset < 4 {
+		return 0, r.errorf("%w", io.ErrUnexpectedEOF)
+	}
+	v := r.endian.Uint32(r.data[r.offset:])
+	r.offset += 4
+	return v, nil
+}
+
+// count reads the number of items that follow, each being at least
+// itemSize bytes, rejecting counts the remaining data can't hold
+func (r *wkbReader) count(itemSize int) (int, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n)*uint64(itemSize) > uint64(len(r.data)-r.offset) {
+		return 0, r.errorf("count of %d exceeds the remaining data", n)
+	}
+	return int(n), nil
+}
+
+// header reads a geometry's byte order and type, returning the type without
+// any dimension information
+func (r *wkbReader) header() (uint32, Layout, error) {
+	if r.offset >= len(r.data) {
+		return 0, XY, r.errorf("%w", io.ErrUnexpectedEOF)
+	}
+	switch r.data[r.offset] {
+	case 0:
+		r.endian = binary.BigEndian
+	case 1:
+		r.endian = binary.LittleEndian
+	default:
+		return 0, XY, r.errorf("invalid byte order: %d", r.data[r.offset])
+	}
+	r.offset++
+
+	geometryType, err := r.uint32()
+	if err != nil {
+		return 0, XY, err
+	}
+
+	layout := XY
+	if geometryType&ewkbM != 0 {
+		return 0, XY, r.errorf("measured geometries are not supported")
+	}
+	if geometryType&ewkbZ != 0 {
+		layout = XYZ
+	}
+	if geometryType&ewkbSRID != 0 {
+		if _, err := r.uint32(); err != nil {
+			return 0, XY, err
+		}
+	}
+	geometryType &^= ewkbZ | ewkbM | ewkbSRID
+
+	switch geometryType / 1000 {
+	case 0:
+	case 1:
+		layout = XYZ
+	default:
+		return 0, XY, r.errorf("measured geometries are not supported")
+	}
+	geometryType %= 1000
+
+	if _, ok := wkbTypeNames[geometryType]; !ok {
+		return 0, XY, r.errorf("unsupported geometry type: %d", geometryType)
+	}
+	return geometryType, layout, nil
+}
+
+// child reads the header of a geometry nested in a multi geometry
+func (r *wkbReader) child(want uint32) error {
+	geometryType, layout, err := r.header()
+	if err != nil {
+		return err
+	}
+	if geometryType != want {
+		return r.errorf("expected a %s, found a %s", wkbTypeNames[want], wkbTypeNames[geometryType])
+	}
+	if layout != r.layout {
+		return r.errorf("nested geometry has a different layout than its parent")
+	}
+	return nil
+}
+
+func (r *wkbReader) position() (vector3.Float64, error) {
+	size := 8 * r.layout.dimensions()
+	if len(r.data)-r.offset < size {
+		return vector3.Float64{}, r.errorf("%w", io.ErrUnexpectedEOF)
+	}
+	data := r.data[r.offset:]
+	r.offset += size
+
+	x := math.Float64frombits(r.endian.Uint64(data))
+	y := math.Float64frombits(r.endian.Uint64(data[8:]))
+	if r.layout == XYZ {
+		return vector3.New(x, y, math.Float64frombits(r.endian.Uint64(data[16:]))), nil
+	}
+	return vector3.New(x, y, 0), nil
+}
+
+func (r *wkbReader) positions() (vector3.Float64Array, error) {
+	n, err := r.count(8 * r.layout.dimensions())
+	if err != nil {
+		return nil, err
+	}
+	out := make(vector3.Float64Array, n)
+	for i := range out {
+		if out[i], err = r.position(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (r *wkbReader) rings() ([]vector3.Float64Array, error) {
+	n, err := r.count(4)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]vector3.Float64Array, n)
+	for i := range out {
+		if out[i], err = r.positions(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalWKB decodes ISO Well-Known Binary, along with the extended WKB
+// written by PostGIS, whose SRID is discarded. Measured geometries are not
+// supported.
+func UnmarshalWKB(data []byte) (Geometry, error) {
+	r := wkbReader{data: data}
+	geometryType, layout, err := r.header()
+	if err != nil {
+		return nil, err
+	}
+	r.layout = layout
+
+	g, err := r.geometry(geometryType)
+	if err != nil {
+		return nil, err
+	}
+	if r.offset != len(data) {
+		return nil, r.errorf("unexpected data after geometry")
+	}
+	if err := g.validate(); err != nil {
+		return nil, fmt.Errorf("wkb %s: %w", g.Type(), err)
+	}
+	return g, nil
+}
+
+// geometry reads the body of a geometry following its header
+func (r *wkbReader) geometry(geometryType uint32) (Geometry, error) {
+	switch geometryType {
+	case wkbPoint:
+		v, err := r.position()
+		return Point{Layout: r.layout, Coordinates: v}, err
+
+	case wkbLineString:
+		arr, err := r.positions()
+		return LineString{Layout: r.layout, Coordinates: arr}, err
+
+	case wkbPolygon:
+		rings, err := r.rings()
+		return Polygon{Layout: r.layout, Rings: rings}, err
+
+	case wkbMultiPoint:
+		n, err := r.count(5)
+		if err != nil {
+			return nil, err
+		}
+		points := make(vector3.Float64Array, n)
+		for i := range points {
+			if err := r.child(wkbPoint); err != nil {
+				return nil, err
+			}
+			if points[i], err = r.position(); err != nil {
+				return nil, err
+			}
+		}
+		return MultiPoint{Layout: r.layout, Points: points}, nil
+
+	case wkbMultiLineString:
+		n, err := r.count(5)
+		if err != nil {
+			return nil, err
+		}
+		lines := make([]vector3.Float64Array, n)
+		for i := range lines {
+			if err := r.child(wkbLineString); err != nil {
+				return nil, err
+			}
+			if lines[i], err = r.positions(); err != nil {
+				return nil, err
+			}
+		}
+		return MultiLineString{Layout: r.layout, LineStrings: lines}, nil
+	}
+
+	n, err := r.count(5)
+	if err != nil {
+		return nil, err
+	}
+	polygons := make([][]vector3.Float64Array, n)
+	for i := range polygons {
+		if err := r.child(wkbPolygon); err != nil {
+			return nil, err
+		}
+		if polygons[i], err = r.rings(); err != nil {
+			return nil, err
+		}
+	}
+	return MultiPolygon{Layout: r.layout, Polygons: polygons}, nil
+}
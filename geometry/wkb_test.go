@@ -0,0 +1,209 @@
+package geometry_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/geometry"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeHex(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return data
+}
+
+func TestWKB(t *testing.T) {
+	endians := map[string]binary.ByteOrder{
+		"little endian": binary.LittleEndian,
+		"big endian":    binary.BigEndian,
+	}
+
+	for name, tc := range encodingCases {
+		for endianName, endian := range endians {
+			t.Run(name+"/"+endianName, func(t *testing.T) {
+				data, err := geometry.MarshalWKB(tc.geometry, endian)
+				assert.NoError(t, err)
+
+				back, err := geometry.UnmarshalWKB(data)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.geometry, back)
+			})
+		}
+	}
+}
+
+func TestMarshalWKB_Known(t *testing.T) {
+	tests := map[string]struct {
+		geometry geometry.Geometry
+		endian   binary.ByteOrder
+		want     string
+	}{
+		"point": {
+			geometry: geometry.Point2D(vector2.New(1., 2.)),
+			endian:   binary.LittleEndian,
+			want:     "0101000000000000000000f03f0000000000000040",
+		},
+		"point big endian": {
+			geometry: geometry.Point2D(vector2.New(1., 2.)),
+			endian:   binary.BigEndian,
+			want:     "00000000013ff00000000000004000000000000000",
+		},
+		"point z": {
+			geometry: geometry.Point{Layout: geometry.XYZ, Coordinates: vector3.New(1., 2., 3.)},
+			endian:   binary.LittleEndian,
+			want:     "01e9030000000000000000f03f00000000000000400000000000000840",
+		},
+		"multi point": {
+			geometry: geometry.MultiPoint2D(vector2.Float64Array{vector2.New(1., 2.)}),
+			endian:   binary.LittleEndian,
+			want:     "010400000001000000" + "0101000000000000000000f03f0000000000000040",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := geometry.MarshalWKB(tc.geometry, tc.endian)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, hex.EncodeToString(data))
+		})
+	}
+}
+
+func TestMarshalWKB_Invalid(t *testing.T) {
+	for name, tc := range invalidGeometries {
+		t.Run(name, func(t *testing.T) {
+			data, err := geometry.MarshalWKB(tc.geometry, binary.LittleEndian)
+			assert.Nil(t, data)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestMarshalWKB_UnsupportedByteOrder(t *testing.T) {
+	assert.PanicsWithError(t, "unsupported byte order: NativeEndian", func() {
+		geometry.MarshalWKB(geometry.Point{}, binary.NativeEndian)
+	})
+}
+
+func TestWKB_EmptyPoint(t *testing.T) {
+	g, err := geometry.UnmarshalWKT("POINT EMPTY")
+	assert.NoError(t, err)
+
+	data, err := geometry.MarshalWKB(g, binary.LittleEndian)
+	assert.NoError(t, err)
+
+	back, err := geometry.UnmarshalWKB(data)
+	assert.NoError(t, err)
+	assert.True(t, back.(geometry.Point).Empty())
+}
+
+func TestUnmarshalWKB_EWKB(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  geometry.Geometry
+	}{
+		"srid": {
+			// SRID=4326;POINT(1 2)
+			input: "0101000020e6100000000000000000f03f0000000000000040",
+			want:  geometry.Point2D(vector2.New(1., 2.)),
+		},
+		"z flag": {
+			// POINT(1 2 3)
+			input: "0101000080000000000000f03f00000000000000400000000000000840",
+			want:  geometry.Point{Layout: geometry.XYZ, Coordinates: vector3.New(1., 2., 3.)},
+		},
+		"mixed byte order": {
+			input: "0104000000010000000000000001" + "3ff00000000000004000000000000000",
+			want:  geometry.MultiPoint2D(vector2.Float64Array{vector2.New(1., 2.)}),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.UnmarshalWKB(decodeHex(t, tc.input))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, g)
+		})
+	}
+}
+
+func TestUnmarshalWKB_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"empty": {
+			input: "",
+			err:   "wkb offset 0: unexpected EOF",
+		},
+		"invalid byte order": {
+			input: "02",
+			err:   "wkb offset 0: invalid byte order: 2",
+		},
+		"unsupported type": {
+			input: "0107000000",
+			err:   "wkb offset 5: unsupported geometry type: 7",
+		},
+		"measured": {
+			input: "01d1070000",
+			err:   "wkb offset 5: measured geometries are not supported",
+		},
+		"truncated point": {
+			input: "0101000000000000000000f03f",
+			err:   "wkb offset 5: unexpected EOF",
+		},
+		"huge count": {
+			input: "0102000000ffffffff",
+			err:   "wkb offset 9: count of 4294967295 exceeds the remaining data",
+		},
+		"wrong child type": {
+			input: "010400000001000000" + "010200000000000000",
+			err:   "wkb offset 14: expected a Point, found a LineString",
+		},
+		"child layout": {
+			input: "010400000001000000" + "01e9030000000000000000f03f00000000000000400000000000000840",
+			err:   "wkb offset 14: nested geometry has a different layout than its parent",
+		},
+		"trailing data": {
+			input: "0101000000000000000000f03f000000000000004000",
+			err:   "wkb offset 21: unexpected data after geometry",
+		},
+		"open ring": {
+			input: "01030000000100000004000000" +
+				strings.Repeat("00", 16) +
+				"000000000000f03f" + strings.Repeat("00", 8) +
+				"000000000000f03f000000000000f03f" +
+				strings.Repeat("00", 8) + "000000000000f03f",
+			err: "wkb Polygon: ring 0 is not closed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.UnmarshalWKB(decodeHex(t, tc.input))
+			assert.Nil(t, g)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestUnmarshalWKB_Truncated(t *testing.T) {
+	data, err := geometry.MarshalWKB(encodingCases["multi polygon with holes"].geometry, binary.BigEndian)
+	assert.NoError(t, err)
+
+	for i := range len(data) {
+		_, err := geometry.UnmarshalWKB(data[:i])
+		assert.Error(t, err)
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			assert.ErrorContains(t, err, "exceeds the remaining data")
+		}
+	}
+}
@@ -0,0 +1,381 @@
+package geometry
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+var wktTags = map[string]string{
+	"POINT":           "Point",
+	"LINESTRING":      "LineString",
+	"POLYGON":         "Polygon",
+	"MULTIPOINT":      "MultiPoint",
+	"MULTILINESTRING": "MultiLineString",
+	"MULTIPOLYGON":    "MultiPolygon",
+}
+
+type wktWriter struct {
+	layout Layout
+	buf    []byte
+}
+
+func (w *wktWriter) position(v vector3.Float64) {
+	w.buf = strconv.AppendFloat(w.buf, v.X(), 'g', -1, 64)
+	w.buf = append(w.buf, ' ')
+	w.buf = strconv.AppendFloat(w.buf, v.Y(), 'g', -1, 64)
+	if w.layout == XYZ {
+		w.buf = append(w.buf, ' ')
+		w.buf = strconv.AppendFloat(w.buf, v.Z(), 'g', -1, 64)
+	}
+}
+
+func (w *wktWriter) positions(arr vector3.Float64Array) {
+	if len(arr) == 0 {
+		w.buf = append(w.buf, "EMPTY"...)
+		return
+	}
+	w.buf = append(w.buf, '(')
+	for i, v := range arr {
+		if i > 0 {
+			w.buf = append(w.buf, ", "...)
+		}
+		w.position(v)
+	}
+	w.buf = append(w.buf, ')')
+}
+
+func (w *wktWriter) positionLists(arrs []vector3.Float64Array) {
+	if len(arrs) == 0 {
+		w.buf = append(w.buf, "EMPTY"...)
+		return
+	}
+	w.buf = append(w.buf, '(')
+	for i, arr := range arrs {
+		if i > 0 {
+			w.buf = append(w.buf, ", "...)
+		}
+		w.positions(arr)
+	}
+	w.buf = append(w.buf, ')')
+}
+
+// MarshalWKT encodes the geometry as Well-Known Text, tagging XYZ geometries
+// with Z
+func MarshalWKT(g Geometry) (string, error) {
+	if err := g.validate(); err != nil {
+		return "", err
+	}
+
+	w := wktWriter{layout: g.layout()}
+	w.buf = append(w.buf, strings.ToUpper(g.Type())...)
+	w.buf = append(w.buf, ' ')
+	if w.layout == XYZ {
+		w.buf = append(w.buf, "Z "...)
+	}
+
+	switch g := g.(type) {
+	case Point:
+		if g.Empty() {
+			w.buf = append(w.buf, "EMPTY"...)
+		} else {
+			w.buf = append(w.buf, '(')
+			w.position(g.Coordinates)
+			w.buf = append(w.buf, ')')
+		}
+
+	case LineString:
+		w.positions(g.Coordinates)
+
+	case Polygon:
+		w.positionLists(g.Rings)
+
+	case MultiPoint:
+		if len(g.Points) == 0 {
+			w.buf = append(w.buf, "EMPTY"...)
+			break
+		}
+		w.buf = append(w.buf, '(')
+		for i, v := range g.Points {
+			if i > 0 {
+				w.buf = append(w.buf, ", "...)
+			}
+			w.buf = append(w.buf, '(')
+			w.position(v)
+			w.buf = append(w.buf, ')')
+		}
+		w.buf = append(w.buf, ')')
+
+	case MultiLineString:
+		w.positionLists(g.LineStrings)
+
+	case MultiPolygon:
+		if len(g.Polygons) == 0 {
+			w.buf = append(w.buf, "EMPTY"...)
+			break
+		}
+		w.buf = append(w.buf, '(')
+		for i, rings := range g.Polygons {
+			if i > 0 {
+				w.buf = append(w.buf, ", "...)
+			}
+			w.positionLists(rings)
+		}
+		w.buf = append(w.buf, ')')
+	}
+	return string(w.buf), nil
+}
+
+// wktParser reads Well-Known Text, collecting positions as nested slices
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) errorf(format string, a ...any) error {
+	return fmt.Errorf("wkt offset %d: %w", p.pos, fmt.Errorf(format, a...))
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *wktParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *wktParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+// word reads a run of letters, returning it in upper case
+func (p *wktParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos] | 0x20
+		if c < 'a' || c > 'z' {
+			break
+		}
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+// empty consumes the EMPTY keyword if it's next
+func (p *wktParser) empty() bool {
+	start := p.pos
+	if p.word() == "EMPTY" {
+		return true
+	}
+	p.pos = start
+	return false
+}
+
+func (p *wktParser) position() ([]float64, error) {
+	var position []float64
+	for {
+		c := p.peek()
+		if c == ',' || c == ')' || c == 0 {
+			break
+		}
+		start := p.pos
+		for p.pos < len(p.s) && strings.IndexByte(" \t\r\n,()", p.s[p.pos]) < 0 {
+			p.pos++
+		}
+		token := p.s[start:p.pos]
+		v, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			p.pos = start
+			return nil, p.errorf("invalid number: %q", token)
+		}
+		position = append(position, v)
+	}
+	if len(position) == 0 {
+		return nil, p.errorf("expected a position")
+	}
+	return position, nil
+}
+
+// list reads a parenthesized, comma separated list of items, or EMPTY
+func (p *wktParser) list(item func() error) error {
+	if p.empty() {
+		return nil
+	}
+	if err := p.expect('('); err != nil {
+		return err
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+	return p.expect(')')
+}
+
+func (p *wktParser) positions() ([][]float64, error) {
+	out := [][]float64{}
+	err := p.list(func() error {
+		position, err := p.position()
+		out = append(out, position)
+		return err
+	})
+	return out, err
+}
+
+func (p *wktParser) positionLists() ([][][]float64, error) {
+	out := [][][]float64{}
+	err := p.list(func() error {
+		positions, err := p.positions()
+		out = append(out, positions)
+		return err
+	})
+	return out, err
+}
+
+// multiPoint reads the positions of a multi point, which may or may not be
+// individually parenthesized
+func (p *wktParser) multiPoint() ([][]float64, error) {
+	out := [][]float64{}
+	err := p.list(func() error {
+		if p.peek() != '(' {
+			position, err := p.position()
+			out = append(out, position)
+			return err
+		}
+		p.pos++
+		position, err := p.position()
+		if err != nil {
+			return err
+		}
+		out = append(out, position)
+		return p.expect(')')
+	})
+	return out, err
+}
+
+// UnmarshalWKT decodes Well-Known Text. Geometries tagged with Z, or whose
+// positions have three values, produce XYZ geometries. Measured geometries
+// are not supported.
+func UnmarshalWKT(s string) (Geometry, error) {
+	p := wktParser{s: s}
+	tag := p.word()
+	typeName, ok := wktTags[tag]
+	if !ok {
+		return nil, fmt.Errorf("unsupported wkt geometry type: %q", tag)
+	}
+
+	tracker := layoutTracker{}
+	start := p.pos
+	switch p.word() {
+	case "Z":
+		tracker.dimensions = 3
+	case "M", "ZM":
+		return nil, fmt.Errorf("wkt measured geometries are not supported")
+	default:
+		p.pos = start
+	}
+
+	g, err := p.geometry(typeName, &tracker)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() != 0 {
+		return nil, p.errorf("unexpected text after geometry")
+	}
+	if err := g.validate(); err != nil {
+		return nil, fmt.Errorf("wkt %s: %w", typeName, err)
+	}
+	return g, nil
+}
+
+// geometry reads the body of a geometry following its tag
+func (p *wktParser) geometry(typeName string, tracker *layoutTracker) (Geometry, error) {
+	switch typeName {
+	case "Point":
+		if p.empty() {
+			return Point{Layout: tracker.layout(), Coordinates: emptyPoint}, nil
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		position, err := p.position()
+		if err != nil {
+			return nil, err
+		}
+		v, err := tracker.vector(position)
+		if err != nil {
+			return nil, p.errorf("%w", err)
+		}
+		return Point{Layout: tracker.layout(), Coordinates: v}, p.expect(')')
+
+	case "LineString", "MultiPoint":
+		var positions [][]float64
+		var err error
+		if typeName == "MultiPoint" {
+			positions, err = p.multiPoint()
+		} else {
+			positions, err = p.positions()
+		}
+		if err != nil {
+			return nil, err
+		}
+		arr, err := tracker.array(positions)
+		if err != nil {
+			return nil, p.errorf("%w", err)
+		}
+		if typeName == "MultiPoint" {
+			return MultiPoint{Layout: tracker.layout(), Points: arr}, nil
+		}
+		return LineString{Layout: tracker.layout(), Coordinates: arr}, nil
+
+	case "Polygon", "MultiLineString":
+		lists, err := p.positionLists()
+		if err != nil {
+			return nil, err
+		}
+		arrs, err := tracker.arrays(lists)
+		if err != nil {
+			return nil, p.errorf("%w", err)
+		}
+		if typeName == "MultiLineString" {
+			return MultiLineString{Layout: tracker.layout(), LineStrings: arrs}, nil
+		}
+		return Polygon{Layout: tracker.layout(), Rings: arrs}, nil
+	}
+
+	polygons := [][]vector3.Float64Array{}
+	err := p.list(func() error {
+		lists, err := p.positionLists()
+		if err != nil {
+			return err
+		}
+		arrs, err := tracker.arrays(lists)
+		if err != nil {
+			return p.errorf("%w", err)
+		}
+		polygons = append(polygons, arrs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MultiPolygon{Layout: tracker.layout(), Polygons: polygons}, nil
+}
@@ -0,0 +1,133 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/geometry"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWKT(t *testing.T) {
+	for name, tc := range encodingCases {
+		t.Run(name, func(t *testing.T) {
+			wkt, err := geometry.MarshalWKT(tc.geometry)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wkt, wkt)
+
+			back, err := geometry.UnmarshalWKT(wkt)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.geometry, back)
+		})
+	}
+}
+
+func TestMarshalWKT_Invalid(t *testing.T) {
+	for name, tc := range invalidGeometries {
+		t.Run(name, func(t *testing.T) {
+			wkt, err := geometry.MarshalWKT(tc.geometry)
+			assert.Empty(t, wkt)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestUnmarshalWKT_Variants(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  geometry.Geometry
+	}{
+		"lower case": {
+			input: "point(1 2)",
+			want:  geometry.Point2D(vector2.New(1., 2.)),
+		},
+		"untagged z": {
+			input: "POINT (1 2 3)",
+			want:  geometry.Point{Layout: geometry.XYZ, Coordinates: vector3.New(1., 2., 3.)},
+		},
+		"extra whitespace": {
+			input: "  LINESTRING\n(\t0 0 ,1   1 )  ",
+			want:  geometry.LineString2D(vector2.Float64Array{vector2.New(0., 0.), vector2.New(1., 1.)}),
+		},
+		"unparenthesized multi point": {
+			input: "MULTIPOINT (1 2, 3 4)",
+			want:  geometry.MultiPoint2D(vector2.Float64Array{vector2.New(1., 2.), vector2.New(3., 4.)}),
+		},
+		"scientific notation": {
+			input: "POINT (1e3 -2.5E-1)",
+			want:  geometry.Point2D(vector2.New(1000., -0.25)),
+		},
+		"empty polygon": {
+			input: "POLYGON Z EMPTY",
+			want:  geometry.Polygon{Layout: geometry.XYZ, Rings: []vector3.Float64Array{}},
+		},
+		"empty nested line string": {
+			input: "MULTILINESTRING (EMPTY, (0 0, 1 1))",
+			want: geometry.MultiLineString2D(
+				vector2.Float64Array{},
+				vector2.Float64Array{vector2.New(0., 0.), vector2.New(1., 1.)},
+			),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.UnmarshalWKT(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, g)
+		})
+	}
+}
+
+func TestUnmarshalWKT_Errors(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"unsupported type": {
+			input: "GEOMETRYCOLLECTION EMPTY",
+			err:   `unsupported wkt geometry type: "GEOMETRYCOLLECTION"`,
+		},
+		"measured": {
+			input: "POINT M (1 2 3)",
+			err:   "wkt measured geometries are not supported",
+		},
+		"invalid number": {
+			input: "POINT (1 two)",
+			err:   `wkt offset 9: invalid number: "two"`,
+		},
+		"missing parenthesis": {
+			input: "LINESTRING (0 0, 1 1",
+			err:   `wkt offset 20: expected ')'`,
+		},
+		"missing position": {
+			input: "LINESTRING (0 0, )",
+			err:   "wkt offset 17: expected a position",
+		},
+		"mixed dimensions": {
+			input: "LINESTRING (0 0, 1 1 1)",
+			err:   "wkt offset 23: position has 3 values, expected 2",
+		},
+		"z tag with 2d position": {
+			input: "POINT Z (1 2)",
+			err:   "wkt offset 12: position has 2 values, expected 3",
+		},
+		"trailing text": {
+			input: "POINT (1 2) POINT (3 4)",
+			err:   "wkt offset 12: unexpected text after geometry",
+		},
+		"open ring": {
+			input: "POLYGON ((0 0, 1 0, 1 1, 0 1))",
+			err:   "wkt Polygon: ring 0 is not closed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := geometry.UnmarshalWKT(tc.input)
+			assert.Nil(t, g)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package hashgrid_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/hashgrid"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func bruteForceNeighbors2(points map[int]vector2.Float64, target vector2.Float64, radius float64) []int {
+	out := make([]int, 0)
+	for id, p := range points {
+		if p.Distance(target) <= radius {
+			out = append(out, id)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func bruteForcePairs2(points map[int]vector2.Float64, distance float64) []hashgrid.Pair {
+	out := make([]hashgrid.Pair, 0)
+	for a, pa := range points {
+		for b, pb := range points {
+			if a < b && pa.Distance(pb) <= distance {
+				out = append(out, hashgrid.Pair{A: a, B: b})
+			}
+		}
+	}
+	sortPairs(out)
+	return out
+}
+
+func randRange2(r *rand.Rand, min, max float64) vector2.Float64 {
+	return vector2.New(
+		min+r.Float64()*(max-min),
+		min+r.Float64()*(max-min),
+	)
+}
+
+func TestGrid2_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	grid := hashgrid.NewGrid2[float64](5)
+	assert.Equal(t, 5., grid.CellSize())
+
+	points := make(map[int]vector2.Float64)
+	for i := 0; i < 1000; i++ {
+		points[i] = randRange2(r, -50., 50.)
+		grid.Insert(i, points[i])
+	}
+
+	// Shuffle everything around, with some staying within their own cell
+	for i := 0; i < 1000; i += 2 {
+		points[i] = points[i].Add(randRange2(r, -1., 1.))
+		assert.True(t, grid.Update(i, points[i]))
+	}
+	for i := 1; i < 1000; i += 3 {
+		points[i] = randRange2(r, -50., 50.)
+		grid.Insert(i, points[i])
+	}
+	for i := 0; i < 1000; i += 5 {
+		delete(points, i)
+		assert.True(t, grid.Remove(i))
+	}
+	assert.Equal(t, len(points), grid.Len())
+
+	for id, want := range points {
+		got, ok := grid.Position(id)
+		assert.True(t, ok)
+		assert.Equal(t, want, got)
+	}
+
+	for _, radius := range []float64{0, 2.5, 5, 12, 500} {
+		t.Run(fmt.Sprintf("neighbors radius %g", radius), func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				target := randRange2(r, -60., 60.)
+				got := grid.Neighbors(target, radius)
+				sort.Ints(got)
+				assert.Equal(t, bruteForceNeighbors2(points, target, radius), got)
+			}
+		})
+	}
+
+	for _, distance := range []float64{0, 3, 7, 200} {
+		t.Run(fmt.Sprintf("pairs distance %g", distance), func(t *testing.T) {
+			got := grid.Pairs(distance)
+			sortPairs(got)
+			assert.Equal(t, bruteForcePairs2(points, distance), got)
+		})
+	}
+}
+
+func TestGrid2_MissingItems(t *testing.T) {
+	grid := hashgrid.NewGrid2[float64](1)
+	assert.False(t, grid.Remove(3))
+	assert.False(t, grid.Update(3, vector2.One[float64]()))
+
+	_, ok := grid.Position(3)
+	assert.False(t, ok)
+	assert.Equal(t, 0, grid.Len())
+}
+
+func TestGrid2_Cell(t *testing.T) {
+	grid := hashgrid.NewGrid2[float64](2)
+	assert.Equal(t, vector2.New(0, 0), grid.Cell(vector2.New(0., 1.9)))
+	assert.Equal(t, vector2.New(-1, 2), grid.Cell(vector2.New(-0.1, 5.)))
+}
+
+func TestGrid2_IntPositions(t *testing.T) {
+	grid := hashgrid.NewGrid2[int](4)
+	grid.Insert(1, vector2.New(0, 0))
+	grid.Insert(2, vector2.New(3, 4))
+	grid.Insert(3, vector2.New(-10, 0))
+
+	got := grid.Neighbors(vector2.New(0, 0), 5)
+	sort.Ints(got)
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, []hashgrid.Pair{{A: 1, B: 2}}, grid.Pairs(5))
+}
+
+func TestGrid2_StopsEarly(t *testing.T) {
+	grid := hashgrid.NewGrid2[float64](1)
+	for i := 0; i < 10; i++ {
+		grid.Insert(i, vector2.Fill(float64(i)*0.01))
+	}
+
+	visited := 0
+	grid.ForEachNeighbor(vector2.Zero[float64](), 1, func(id int, position vector2.Float64) bool {
+		visited++
+		return visited < 3
+	})
+	assert.Equal(t, 3, visited)
+
+	visited = 0
+	grid.ForEachPair(1, func(a, b int) bool {
+		visited++
+		return visited < 4
+	})
+	assert.Equal(t, 4, visited)
+}
+
+func TestGrid2_InvalidCellSize(t *testing.T) {
+	assert.PanicsWithError(t, "invalid cell size: 0", func() {
+		hashgrid.NewGrid2[float64](0)
+	})
+	assert.PanicsWithError(t, "invalid cell size: -2", func() {
+		hashgrid.NewGrid2[float64](-2)
+	})
+}
+
+func BenchmarkGrid2_Pairs(b *testing.B) {
+	for _, testLen := range []int{1_000, 100_000} {
+		r := rand.New(rand.NewSource(42))
+		grid := hashgrid.NewGrid2[float64](1)
+		for i := 0; i < testLen; i++ {
+			grid.Insert(i, randRange2(r, -50., 50.))
+		}
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				pairsResult = len(grid.Pairs(1))
+			}
+		})
+	}
+}
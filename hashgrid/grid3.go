@@ -0,0 +1,289 @@
+package hashgrid
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+type item3[T vector.Number] struct {
+	id       int
+	position vector3.Vector[T]
+}
+
+// Grid3 buckets vector3 positions into uniformly sized cubic cells, keyed by
+// the FloorToInt of each position divided by the cell size. Only occupied
+// cells are stored, so the grid is unbounded. Queries are fastest when the
+// cell size is close to the radius most commonly searched.
+type Grid3[T vector.Number] struct {
+	cellSize  float64
+	cells     map[vector3.Int][]item3[T]
+	positions map[int]vector3.Vector[T]
+}
+
+// NewGrid3 creates an empty grid with cells of the size provided
+func NewGrid3[T vector.Number](cellSize float64) *Grid3[T] {
+	validateCellSize(cellSize)
+	return &Grid3[T]{
+		cellSize:  cellSize,
+		cells:     make(map[vector3.Int][]item3[T]),
+		positions: make(map[int]vector3.Vector[T]),
+	}
+}
+
+// CellSize is the length of each side of a cell
+func (g *Grid3[T]) CellSize() float64 {
+	return g.cellSize
+}
+
+// Len returns the number of items within the grid
+func (g *Grid3[T]) Len() int {
+	return len(g.positions)
+}
+
+// Cell returns the coordinates of the cell the position falls within
+func (g *Grid3[T]) Cell(position vector3.Vector[T]) vector3.Int {
+	return position.ToFloat64().DivByConstant(g.cellSize).FloorToInt()
+}
+
+// Position returns where the item with the ID provided is stored, and
+// whether or not it exists within the grid
+func (g *Grid3[T]) Position(id int) (vector3.Vector[T], bool) {
+	p, ok := g.positions[id]
+	return p, ok
+}
+
+// Insert adds an item to the grid at the position provided. If an item with
+// the same ID already exists, it is moved to the new position.
+func (g *Grid3[T]) Insert(id int, position vector3.Vector[T]) {
+	if g.Update(id, position) {
+		return
+	}
+	cell := g.Cell(position)
+	g.cells[cell] = append(g.cells[cell], item3[T]{id: id, position: position})
+	g.positions[id] = position
+}
+
+// Remove takes the item with the ID provided out of the grid, returning
+// whether or not it was present
+func (g *Grid3[T]) Remove(id int) bool {
+	position, ok := g.positions[id]
+	if !ok {
+		return false
+	}
+	g.removeFromCell(g.Cell(position), id)
+	delete(g.positions, id)
+	return true
+}
+
+func (g *Grid3[T]) removeFromCell(cell vector3.Int, id int) {
+	items := g.cells[cell]
+	for i, it := range items {
+		if it.id != id {
+			continue
+		}
+		last := len(items) - 1
+		items[i] = items[last]
+		if last == 0 {
+			delete(g.cells, cell)
+		} else {
+			g.cells[cell] = items[:last]
+		}
+		return
+	}
+}
+
+// Update moves the item with the ID provided to a new position, returning
+// false if the item does not exist within the grid
+func (g *Grid3[T]) Update(id int, position vector3.Vector[T]) bool {
+	old, ok := g.positions[id]
+	if !ok {
+		return false
+	}
+	g.positions[id] = position
+
+	oldCell := g.Cell(old)
+	newCell := g.Cell(position)
+	if oldCell == newCell {
+		items := g.cells[oldCell]
+		for i := range items {
+			if items[i].id == id {
+				items[i].position = position
+				break
+			}
+		}
+		return true
+	}
+
+	g.removeFromCell(oldCell, id)
+	g.cells[newCell] = append(g.cells[newCell], item3[T]{id: id, position: position})
+	return true
+}
+
+// ForEachNeighbor calls visit for every item within radius of the position
+// provided, in no particular order. Iteration stops early if visit returns
+// false.
+func (g *Grid3[T]) ForEachNeighbor(position vector3.Vector[T], radius float64, visit func(id int, position vector3.Vector[T]) bool) {
+	if radius < 0 {
+		return
+	}
+
+	radiusSquared := radius * radius
+	visitCell := func(items []item3[T]) bool {
+		for _, it := range items {
+			if it.position.DistanceSquared(position) <= radiusSquared {
+				if !visit(it.id, it.position) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	p := position.ToFloat64()
+	lo := p.Sub(vector3.Fill(radius)).DivByConstant(g.cellSize).FloorToInt()
+	hi := p.Add(vector3.Fill(radius)).DivByConstant(g.cellSize).FloorToInt()
+	span := hi.Sub(lo).Add(vector3.One[int]())
+
+	// Scanning the occupied cells is cheaper than looking up every cell the
+	// radius covers once the radius spans many more cells than are occupied
+	if float64(span.X())*float64(span.Y())*float64(span.Z()) > float64(len(g.cells)) {
+		for cell, items := range g.cells {
+			if cell.X() < lo.X() || cell.Y() < lo.Y() || cell.Z() < lo.Z() ||
+				cell.X() > hi.X() || cell.Y() > hi.Y() || cell.Z() > hi.Z() {
+				continue
+			}
+			if !visitCell(items) {
+				return
+			}
+		}
+		return
+	}
+
+	for x := lo.X(); x <= hi.X(); x++ {
+		for y := lo.Y(); y <= hi.Y(); y++ {
+			for z := lo.Z(); z <= hi.Z(); z++ {
+				if !visitCell(g.cells[vector3.New(x, y, z)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Neighbors returns the IDs of every item within radius of the position
+// provided, in no particular order
+func (g *Grid3[T]) Neighbors(position vector3.Vector[T], radius float64) []int {
+	out := make([]int, 0)
+	g.ForEachNeighbor(position, radius, func(id int, _ vector3.Vector[T]) bool {
+		out = append(out, id)
+		return true
+	})
+	return out
+}
+
+func cellLess3(a, b vector3.Int) bool {
+	if a.X() != b.X() {
+		return a.X() < b.X()
+	}
+	if a.Y() != b.Y() {
+		return a.Y() < b.Y()
+	}
+	return a.Z() < b.Z()
+}
+
+// ForEachPair calls visit once for every pair of items within distance of
+// one another, in no particular order. Iteration stops early if visit
+// returns false.
+func (g *Grid3[T]) ForEachPair(distance float64, visit func(a, b int) bool) {
+	if distance < 0 {
+		return
+	}
+
+	distanceSquared := distance * distance
+	reach := cellReach(distance, g.cellSize)
+
+	visitWithin := func(items []item3[T]) bool {
+		for i, a := range items {
+			for _, b := range items[i+1:] {
+				if a.position.DistanceSquared(b.position) <= distanceSquared {
+					if !visit(a.id, b.id) {
+						return false
+					}
+				}
+			}
+		}
+		return true
+	}
+
+	visitBetween := func(as, bs []item3[T]) bool {
+		for _, a := range as {
+			for _, b := range bs {
+				if a.position.DistanceSquared(b.position) <= distanceSquared {
+					if !visit(a.id, b.id) {
+						return false
+					}
+				}
+			}
+		}
+		return true
+	}
+
+	// Only half of the surrounding cells need checking from each cell, as
+	// the other half will check back against it
+	width := 2*reach + 1
+	if float64(width)*float64(width)*float64(width)/2 > float64(len(g.cells)) {
+		for cell, items := range g.cells {
+			if !visitWithin(items) {
+				return
+			}
+			for other, otherItems := range g.cells {
+				if !cellLess3(cell, other) {
+					continue
+				}
+				offset := other.Sub(cell).Abs()
+				if offset.MaxComponent() > reach {
+					continue
+				}
+				if !visitBetween(items, otherItems) {
+					return
+				}
+			}
+		}
+		return
+	}
+
+	offsets := make([]vector3.Int, 0, (width*width*width)/2)
+	zero := vector3.Zero[int]()
+	for x := -reach; x <= reach; x++ {
+		for y := -reach; y <= reach; y++ {
+			for z := -reach; z <= reach; z++ {
+				offset := vector3.New(x, y, z)
+				if cellLess3(zero, offset) {
+					offsets = append(offsets, offset)
+				}
+			}
+		}
+	}
+
+	for cell, items := range g.cells {
+		if !visitWithin(items) {
+			return
+		}
+		for _, offset := range offsets {
+			if !visitBetween(items, g.cells[cell.Add(offset)]) {
+				return
+			}
+		}
+	}
+}
+
+// Pairs returns every pair of items within distance of one another, in no
+// particular order
+func (g *Grid3[T]) Pairs(distance float64) []Pair {
+	out := make([]Pair, 0)
+	g.ForEachPair(distance, func(a, b int) bool {
+		out = append(out, newPair(a, b))
+		return true
+	})
+	return out
+}
@@ -0,0 +1,173 @@
+package hashgrid_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/hashgrid"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func bruteForceNeighbors3(points map[int]vector3.Float64, target vector3.Float64, radius float64) []int {
+	out := make([]int, 0)
+	for id, p := range points {
+		if p.Distance(target) <= radius {
+			out = append(out, id)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func bruteForcePairs3(points map[int]vector3.Float64, distance float64) []hashgrid.Pair {
+	out := make([]hashgrid.Pair, 0)
+	for a, pa := range points {
+		for b, pb := range points {
+			if a < b && pa.Distance(pb) <= distance {
+				out = append(out, hashgrid.Pair{A: a, B: b})
+			}
+		}
+	}
+	sortPairs(out)
+	return out
+}
+
+func sortPairs(pairs []hashgrid.Pair) {
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
+		}
+		return pairs[i].B < pairs[j].B
+	})
+}
+
+func TestGrid3_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	grid := hashgrid.NewGrid3[float64](5)
+	assert.Equal(t, 5., grid.CellSize())
+
+	points := make(map[int]vector3.Float64)
+	for i := 0; i < 1000; i++ {
+		points[i] = vector3.RandRange(r, -50., 50.)
+		grid.Insert(i, points[i])
+	}
+
+	// Shuffle everything around, with some staying within their own cell
+	for i := 0; i < 1000; i += 2 {
+		points[i] = points[i].Add(vector3.RandRange(r, -1., 1.))
+		assert.True(t, grid.Update(i, points[i]))
+	}
+	for i := 1; i < 1000; i += 3 {
+		points[i] = vector3.RandRange(r, -50., 50.)
+		grid.Insert(i, points[i])
+	}
+	for i := 0; i < 1000; i += 5 {
+		delete(points, i)
+		assert.True(t, grid.Remove(i))
+	}
+	assert.Equal(t, len(points), grid.Len())
+
+	for id, want := range points {
+		got, ok := grid.Position(id)
+		assert.True(t, ok)
+		assert.Equal(t, want, got)
+	}
+
+	for _, radius := range []float64{0, 2.5, 5, 12, 500} {
+		t.Run(fmt.Sprintf("neighbors radius %g", radius), func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				target := vector3.RandRange(r, -60., 60.)
+				got := grid.Neighbors(target, radius)
+				sort.Ints(got)
+				assert.Equal(t, bruteForceNeighbors3(points, target, radius), got)
+			}
+		})
+	}
+
+	for _, distance := range []float64{0, 3, 7, 200} {
+		t.Run(fmt.Sprintf("pairs distance %g", distance), func(t *testing.T) {
+			got := grid.Pairs(distance)
+			sortPairs(got)
+			assert.Equal(t, bruteForcePairs3(points, distance), got)
+		})
+	}
+}
+
+func TestGrid3_MissingItems(t *testing.T) {
+	grid := hashgrid.NewGrid3[float64](1)
+	assert.False(t, grid.Remove(3))
+	assert.False(t, grid.Update(3, vector3.One[float64]()))
+
+	_, ok := grid.Position(3)
+	assert.False(t, ok)
+	assert.Equal(t, 0, grid.Len())
+}
+
+func TestGrid3_Cell(t *testing.T) {
+	grid := hashgrid.NewGrid3[float64](2)
+	assert.Equal(t, vector3.New(0, 0, 0), grid.Cell(vector3.New(0., 1.9, 0.1)))
+	assert.Equal(t, vector3.New(-1, 1, 2), grid.Cell(vector3.New(-0.1, 2., 5.)))
+}
+
+func TestGrid3_IntPositions(t *testing.T) {
+	grid := hashgrid.NewGrid3[int](4)
+	grid.Insert(1, vector3.New(0, 0, 0))
+	grid.Insert(2, vector3.New(3, 4, 0))
+	grid.Insert(3, vector3.New(-10, 0, 0))
+
+	got := grid.Neighbors(vector3.New(0, 0, 0), 5)
+	sort.Ints(got)
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, []hashgrid.Pair{{A: 1, B: 2}}, grid.Pairs(5))
+}
+
+func TestGrid3_StopsEarly(t *testing.T) {
+	grid := hashgrid.NewGrid3[float64](1)
+	for i := 0; i < 10; i++ {
+		grid.Insert(i, vector3.Fill(float64(i)*0.01))
+	}
+
+	visited := 0
+	grid.ForEachNeighbor(vector3.Zero[float64](), 1, func(id int, position vector3.Float64) bool {
+		visited++
+		return visited < 3
+	})
+	assert.Equal(t, 3, visited)
+
+	visited = 0
+	grid.ForEachPair(1, func(a, b int) bool {
+		visited++
+		return visited < 4
+	})
+	assert.Equal(t, 4, visited)
+}
+
+func TestGrid3_InvalidCellSize(t *testing.T) {
+	assert.PanicsWithError(t, "invalid cell size: 0", func() {
+		hashgrid.NewGrid3[float64](0)
+	})
+	assert.PanicsWithError(t, "invalid cell size: -2", func() {
+		hashgrid.NewGrid3[float64](-2)
+	})
+}
+
+var pairsResult int
+
+func BenchmarkGrid3_Pairs(b *testing.B) {
+	for _, testLen := range []int{1_000, 100_000} {
+		r := rand.New(rand.NewSource(42))
+		grid := hashgrid.NewGrid3[float64](1)
+		for i := 0; i < testLen; i++ {
+			grid.Insert(i, vector3.RandRange(r, -50., 50.))
+		}
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				pairsResult = len(grid.Pairs(1))
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Package hashgrid provides uniform spatial hash grids for constant time
+// neighbour lookups of vector2 and vector3 positions, such as the particles
+// of a simulation.
+package hashgrid
+
+import (
+	"fmt"
+	"math"
+)
+
+// Pair is two items found within some distance of one another. A is always
+// the smaller of the two IDs.
+type Pair struct {
+	A, B int
+}
+
+func newPair(a, b int) Pair {
+	if a > b {
+		return Pair{A: b, B: a}
+	}
+	return Pair{A: a, B: b}
+}
+
+func validateCellSize(cellSize float64) {
+	if !(cellSize > 0) || math.IsInf(cellSize, 0) {
+		panic(fmt.Errorf("invalid cell size: %g", cellSize))
+	}
+}
+
+// cellReach is how many cells away from an item's own cell another item
+// within distance can be
+func cellReach(distance, cellSize float64) int {
+	return int(math.Ceil(distance / cellSize))
+}
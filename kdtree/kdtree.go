@@ -0,0 +1,265 @@
+// Package kdtree provides a static KD-tree for answering nearest neighbour
+// and radius queries over vector2 and vector3 points. Queries return indices
+// into the original slice of points the tree was built from.
+package kdtree
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Point is any vector that can be stored within a tree. Both vector2.Vector
+// and vector3.Vector satisfy this interface.
+type Point[T vector.Number, P any] interface {
+	Component(index int) T
+	DistanceSquared(other P) float64
+}
+
+// Tree is a balanced KD-tree. The tree is stored implicitly as a permutation
+// of the indices of the original points, where the root of every subtree is
+// the median element of its range.
+type Tree[T vector.Number, P Point[T, P]] struct {
+	dimensions int
+	points     []P
+	order      []int
+}
+
+// New builds a tree over points that have the provided number of dimensions.
+// The slice of points is retained but not modified.
+func New[T vector.Number, P Point[T, P]](points []P, dimensions int) *Tree[T, P] {
+	order := make([]int, len(points))
+	for i := range order {
+		order[i] = i
+	}
+
+	t := &Tree[T, P]{
+		dimensions: dimensions,
+		points:     points,
+		order:      order,
+	}
+	t.build(0, len(order), 0)
+	return t
+}
+
+// FromVector2 builds a tree over a vector2 array
+func FromVector2[T vector.Number](points vector2.Array[T]) *Tree[T, vector2.Vector[T]] {
+	return New[T, vector2.Vector[T]](points, 2)
+}
+
+// FromVector3 builds a tree over a vector3 array
+func FromVector3[T vector.Number](points vector3.Array[T]) *Tree[T, vector3.Vector[T]] {
+	return New[T, vector3.Vector[T]](points, 3)
+}
+
+// Len returns the number of points within the tree
+func (t *Tree[T, P]) Len() int {
+	return len(t.points)
+}
+
+// Point returns the point found at the index of the original array
+func (t *Tree[T, P]) Point(index int) P {
+	return t.points[index]
+}
+
+func (t *Tree[T, P]) component(orderIndex, axis int) float64 {
+	return float64(t.points[t.order[orderIndex]].Component(axis))
+}
+
+func (t *Tree[T, P]) build(lo, hi, depth int) {
+	if hi-lo < 2 {
+		return
+	}
+	mid := (lo + hi) / 2
+	t.selectNth(lo, hi, mid, depth%t.dimensions)
+	t.build(lo, mid, depth+1)
+	t.build(mid+1, hi, depth+1)
+}
+
+// selectNth partially sorts order[lo:hi] so the element at n is the one that
+// would be there if the range was fully sorted along the axis
+func (t *Tree[T, P]) selectNth(lo, hi, n, axis int) {
+	hi--
+	for lo < hi {
+		pivot := t.component((lo+hi)/2, axis)
+		i, j := lo, hi
+		for i <= j {
+			for t.component(i, axis) < pivot {
+				i++
+			}
+			for t.component(j, axis) > pivot {
+				j--
+			}
+			if i <= j {
+				t.order[i], t.order[j] = t.order[j], t.order[i]
+				i++
+				j--
+			}
+		}
+		if n <= j {
+			hi = j
+		} else if n >= i {
+			lo = i
+		} else {
+			return
+		}
+	}
+}
+
+// collector receives candidate points during a search and determines how far
+// away from the target the search still needs to look
+type collector interface {
+	offer(index int, distanceSquared float64)
+	radiusSquared() float64
+}
+
+// search walks the subtree over order[lo:hi]. Far subtrees are skipped when
+// their splitting plane, scaled by shrink, lies outside the collector's radius
+func (t *Tree[T, P]) search(lo, hi, depth int, target P, c collector, shrink float64) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	index := t.order[mid]
+	p := t.points[index]
+	c.offer(index, target.DistanceSquared(p))
+
+	axis := depth % t.dimensions
+	diff := float64(target.Component(axis)) - float64(p.Component(axis))
+
+	nearLo, nearHi, farLo, farHi := lo, mid, mid+1, hi
+	if diff >= 0 {
+		nearLo, nearHi, farLo, farHi = mid+1, hi, lo, mid
+	}
+
+	t.search(nearLo, nearHi, depth+1, target, c, shrink)
+	if diff*diff*shrink <= c.radiusSquared() {
+		t.search(farLo, farHi, depth+1, target, c, shrink)
+	}
+}
+
+type nearestCollector struct {
+	index    int
+	distance float64
+}
+
+func (c *nearestCollector) offer(index int, distanceSquared float64) {
+	if distanceSquared < c.distance {
+		c.index = index
+		c.distance = distanceSquared
+	}
+}
+
+func (c *nearestCollector) radiusSquared() float64 {
+	return c.distance
+}
+
+// Nearest returns the index of the point closest to the target, along with
+// the distance between the two. An index of -1 is returned if the tree is
+// empty.
+func (t *Tree[T, P]) Nearest(target P) (int, float64) {
+	return t.ApproximateNearest(target, 0)
+}
+
+// ApproximateNearest returns the index of a point whose distance to the
+// target is at most (1 + epsilon) times the distance of the true nearest
+// point, along with that distance. Larger values of epsilon visit fewer
+// nodes. An index of -1 is returned if the tree is empty.
+func (t *Tree[T, P]) ApproximateNearest(target P, epsilon float64) (int, float64) {
+	c := &nearestCollector{index: -1, distance: math.Inf(1)}
+	shrink := (1 + epsilon) * (1 + epsilon)
+	t.search(0, len(t.order), 0, target, c, shrink)
+	return c.index, math.Sqrt(c.distance)
+}
+
+type neighbour struct {
+	index    int
+	distance float64
+}
+
+// neighbourHeap is a max heap of the closest neighbours found so far
+type neighbourHeap []neighbour
+
+func (h neighbourHeap) Len() int           { return len(h) }
+func (h neighbourHeap) Less(i, j int) bool { return h[i].distance > h[j].distance }
+func (h neighbourHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *neighbourHeap) Push(x any)        { *h = append(*h, x.(neighbour)) }
+func (h *neighbourHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+type kNearestCollector struct {
+	k     int
+	found neighbourHeap
+}
+
+func (c *kNearestCollector) offer(index int, distanceSquared float64) {
+	if len(c.found) < c.k {
+		heap.Push(&c.found, neighbour{index: index, distance: distanceSquared})
+		return
+	}
+	if distanceSquared < c.found[0].distance {
+		c.found[0] = neighbour{index: index, distance: distanceSquared}
+		heap.Fix(&c.found, 0)
+	}
+}
+
+func (c *kNearestCollector) radiusSquared() float64 {
+	if len(c.found) < c.k {
+		return math.Inf(1)
+	}
+	return c.found[0].distance
+}
+
+// KNearest returns the indices of the k points closest to the target, ordered
+// from nearest to farthest. Fewer than k indices are returned if the tree
+// contains fewer than k points.
+func (t *Tree[T, P]) KNearest(target P, k int) []int {
+	if k <= 0 {
+		return nil
+	}
+
+	c := &kNearestCollector{k: k, found: make(neighbourHeap, 0, min(k, len(t.points)))}
+	t.search(0, len(t.order), 0, target, c, 1)
+
+	sort.Slice(c.found, func(i, j int) bool {
+		return c.found[i].distance < c.found[j].distance
+	})
+
+	out := make([]int, len(c.found))
+	for i, n := range c.found {
+		out[i] = n.index
+	}
+	return out
+}
+
+type radiusCollector struct {
+	radius float64
+	found  []int
+}
+
+func (c *radiusCollector) offer(index int, distanceSquared float64) {
+	if distanceSquared <= c.radius {
+		c.found = append(c.found, index)
+	}
+}
+
+func (c *radiusCollector) radiusSquared() float64 {
+	return c.radius
+}
+
+// WithinRadius returns the indices of all points whose distance to the target
+// is less than or equal to the radius, in no particular order.
+func (t *Tree[T, P]) WithinRadius(target P, radius float64) []int {
+	c := &radiusCollector{radius: radius * radius}
+	t.search(0, len(t.order), 0, target, c, 1)
+	return c.found
+}
@@ -0,0 +1,214 @@
+package kdtree_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/kdtree"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomPoints(r *rand.Rand, n int) vector3.Float64Array {
+	points := make(vector3.Float64Array, n)
+	for i := range points {
+		points[i] = vector3.RandRange(r, -100., 100.)
+	}
+	return points
+}
+
+func bruteForceSorted(points vector3.Float64Array, target vector3.Float64) []int {
+	indices := make([]int, len(points))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return points[indices[i]].DistanceSquared(target) < points[indices[j]].DistanceSquared(target)
+	})
+	return indices
+}
+
+func TestNearest_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	points := randomPoints(r, 2000)
+	tree := kdtree.FromVector3(points)
+	assert.Equal(t, len(points), tree.Len())
+
+	for i := 0; i < 200; i++ {
+		target := vector3.RandRange(r, -120., 120.)
+		want := bruteForceSorted(points, target)[0]
+
+		got, dist := tree.Nearest(target)
+		assert.Equal(t, want, got)
+		assert.InDelta(t, points[want].Distance(target), dist, 1e-9)
+		assert.Equal(t, points[got], tree.Point(got))
+	}
+}
+
+func TestKNearest_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	points := randomPoints(r, 1000)
+	tree := kdtree.FromVector3(points)
+
+	for _, k := range []int{1, 5, 32} {
+		t.Run(fmt.Sprintf("k=%d", k), func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				target := vector3.RandRange(r, -100., 100.)
+				assert.Equal(t, bruteForceSorted(points, target)[:k], tree.KNearest(target, k))
+			}
+		})
+	}
+}
+
+func TestKNearest_MoreThanAvailable(t *testing.T) {
+	points := vector2.Float64Array{
+		vector2.New(0., 0.),
+		vector2.New(5., 0.),
+		vector2.New(1., 0.),
+	}
+	tree := kdtree.FromVector2(points)
+
+	assert.Equal(t, []int{0, 2, 1}, tree.KNearest(vector2.New(-1., 0.), 10))
+	assert.Nil(t, tree.KNearest(vector2.New(-1., 0.), 0))
+}
+
+func TestWithinRadius_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	points := randomPoints(r, 2000)
+	tree := kdtree.FromVector3(points)
+
+	for i := 0; i < 50; i++ {
+		target := vector3.RandRange(r, -100., 100.)
+		radius := r.Float64() * 40
+
+		want := []int{}
+		for j, p := range points {
+			if p.Distance(target) <= radius {
+				want = append(want, j)
+			}
+		}
+
+		got := tree.WithinRadius(target, radius)
+		sort.Ints(got)
+		assert.ElementsMatch(t, want, got)
+	}
+}
+
+func TestApproximateNearest_WithinBound(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	points := randomPoints(r, 5000)
+	tree := kdtree.FromVector3(points)
+
+	for _, epsilon := range []float64{0, 0.1, 0.5, 2} {
+		t.Run(fmt.Sprintf("epsilon=%g", epsilon), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				target := vector3.RandRange(r, -100., 100.)
+				_, exact := tree.Nearest(target)
+
+				index, approx := tree.ApproximateNearest(target, epsilon)
+				assert.NotEqual(t, -1, index)
+				assert.InDelta(t, points[index].Distance(target), approx, 1e-9)
+				assert.LessOrEqual(t, approx, exact*(1+epsilon)+1e-9)
+			}
+		})
+	}
+}
+
+func TestEmptyTree(t *testing.T) {
+	tree := kdtree.FromVector3(vector3.Float64Array{})
+
+	index, dist := tree.Nearest(vector3.Zero[float64]())
+	assert.Equal(t, -1, index)
+	assert.True(t, math.IsInf(dist, 1))
+	assert.Empty(t, tree.KNearest(vector3.Zero[float64](), 3))
+	assert.Empty(t, tree.WithinRadius(vector3.Zero[float64](), 3))
+}
+
+func TestDuplicatePoints(t *testing.T) {
+	points := vector2.IntArray{}
+	for i := 0; i < 100; i++ {
+		points = append(points, vector2.New(3, 3))
+	}
+	points = append(points, vector2.New(10, 10))
+	tree := kdtree.FromVector2(points)
+
+	index, dist := tree.Nearest(vector2.New(9, 9))
+	assert.Equal(t, 100, index)
+	assert.InDelta(t, math.Sqrt2, dist, 1e-9)
+	assert.Len(t, tree.WithinRadius(vector2.New(3, 3), 0), 100)
+}
+
+var arrLenToTest = []int{
+	100,
+	1_000,
+	10_000,
+	100_000,
+	1_000_000,
+}
+
+var nearestResult int
+
+func BenchmarkNearest_BruteForce(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, testLen := range arrLenToTest {
+		points := randomPoints(r, testLen)
+		target := vector3.RandRange(r, -100., 100.)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				best := math.Inf(1)
+				for i, p := range points {
+					if d := p.DistanceSquared(target); d < best {
+						best = d
+						nearestResult = i
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkNearest_KDTree(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, testLen := range arrLenToTest {
+		tree := kdtree.FromVector3(randomPoints(r, testLen))
+		target := vector3.RandRange(r, -100., 100.)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				nearestResult, _ = tree.Nearest(target)
+			}
+		})
+	}
+}
+
+func BenchmarkNearest_KDTreeApproximate(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, testLen := range arrLenToTest {
+		tree := kdtree.FromVector3(randomPoints(r, testLen))
+		target := vector3.RandRange(r, -100., 100.)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				nearestResult, _ = tree.ApproximateNearest(target, 0.5)
+			}
+		})
+	}
+}
+
+func BenchmarkBuild_KDTree(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, testLen := range arrLenToTest {
+		points := randomPoints(r, testLen)
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				nearestResult = kdtree.FromVector3(points).Len()
+			}
+		})
+	}
+}
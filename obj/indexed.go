@@ -0,0 +1,115 @@
+package obj
+
+import (
+	"fmt"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// IndexedMesh is vertex data laid out for rendering, where every vertex has
+// its own position, texture coordinate, and normal, and every three indices
+// form a triangle
+type IndexedMesh struct {
+	Positions vector3.Float64Array
+
+	// TexCoords and Normals are either empty or the same length as
+	// Positions
+	TexCoords vector2.Float64Array
+	Normals   vector3.Float64Array
+
+	Indices []int
+}
+
+// Indexed converts the mesh into an IndexedMesh. Faces with more than three
+// corners are fan triangulated, and corners sharing the same combination of
+// position, texture coordinate, and normal share a single vertex. If only
+// some corners reference texture coordinates or normals, the others are
+// given zero vectors.
+func (m Mesh) Indexed() (IndexedMesh, error) {
+	if err := m.validate(); err != nil {
+		return IndexedMesh{}, err
+	}
+
+	hasTexCoords, hasNormals := false, false
+	for _, face := range m.Faces {
+		for _, c := range face {
+			hasTexCoords = hasTexCoords || c.TexCoord >= 0
+			hasNormals = hasNormals || c.Normal >= 0
+		}
+	}
+
+	out := IndexedMesh{}
+	vertices := make(map[Corner]int)
+	vertex := func(c Corner) int {
+		if index, ok := vertices[c]; ok {
+			return index
+		}
+
+		index := len(out.Positions)
+		vertices[c] = index
+		out.Positions = append(out.Positions, m.Vertices[c.Vertex])
+		if hasTexCoords {
+			texCoord := vector2.Zero[float64]()
+			if c.TexCoord >= 0 {
+				texCoord = m.TexCoords[c.TexCoord]
+			}
+			out.TexCoords = append(out.TexCoords, texCoord)
+		}
+		if hasNormals {
+			normal := vector3.Zero[float64]()
+			if c.Normal >= 0 {
+				normal = m.Normals[c.Normal]
+			}
+			out.Normals = append(out.Normals, normal)
+		}
+		return index
+	}
+
+	for _, face := range m.Faces {
+		first := vertex(face[0])
+		for i := 2; i < len(face); i++ {
+			out.Indices = append(out.Indices, first, vertex(face[i-1]), vertex(face[i]))
+		}
+	}
+	return out, nil
+}
+
+// Mesh converts the indexed mesh back into a mesh of triangles, where every
+// corner references the position, texture coordinate, and normal sharing its
+// index
+func (im IndexedMesh) Mesh() (Mesh, error) {
+	if len(im.Indices)%3 != 0 {
+		return Mesh{}, fmt.Errorf("index count %d is not a multiple of 3", len(im.Indices))
+	}
+	if len(im.TexCoords) != 0 && len(im.TexCoords) != len(im.Positions) {
+		return Mesh{}, fmt.Errorf("indexed mesh has %d texture coordinates, expected %d", len(im.TexCoords), len(im.Positions))
+	}
+	if len(im.Normals) != 0 && len(im.Normals) != len(im.Positions) {
+		return Mesh{}, fmt.Errorf("indexed mesh has %d normals, expected %d", len(im.Normals), len(im.Positions))
+	}
+
+	m := Mesh{
+		Vertices:  im.Positions,
+		TexCoords: im.TexCoords,
+		Normals:   im.Normals,
+		Faces:     make([]Face, 0, len(im.Indices)/3),
+	}
+	for i := 0; i < len(im.Indices); i += 3 {
+		face := make(Face, 3)
+		for j, index := range im.Indices[i : i+3] {
+			if index < 0 || index >= len(im.Positions) {
+				return Mesh{}, fmt.Errorf("index %d references vertex %d, but there are only %d vertices", i+j, index, len(im.Positions))
+			}
+			face[j] = Corner{Vertex: index, TexCoord: -1, Normal: -1}
+			if len(im.TexCoords) > 0 {
+				face[j].TexCoord = index
+			}
+			if len(im.Normals) > 0 {
+				face[j].Normal = index
+			}
+		}
+		m.Faces = append(m.Faces, face)
+	}
+	return m, nil
+}
@@ -0,0 +1,126 @@
+package obj_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/obj"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexed(t *testing.T) {
+	m, err := obj.Read(strings.NewReader(quad))
+	assert.NoError(t, err)
+
+	indexed, err := m.Indexed()
+	assert.NoError(t, err)
+
+	// The shared diagonal is deduplicated
+	assert.Equal(t, vector3.Float64Array{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(1., 1., 0.),
+		vector3.New(0., 1., 0.),
+	}, indexed.Positions)
+	assert.Equal(t, vector2.Float64Array{
+		vector2.New(0., 0.),
+		vector2.New(1., 0.),
+		vector2.New(1., 1.),
+		vector2.New(0.5, 0.),
+	}, indexed.TexCoords)
+	assert.Len(t, indexed.Normals, 4)
+	assert.Equal(t, []int{0, 1, 2, 0, 2, 3}, indexed.Indices)
+}
+
+func TestIndexed_SplitsSeams(t *testing.T) {
+	// The same position used with two different texture coordinates, and a
+	// pentagon that is fan triangulated
+	m, err := obj.Read(strings.NewReader(`v 0 0 0
+v 1 0 0
+v 1 1 0
+v 0.5 1.5 0
+v 0 1 0
+vt 0 0
+vt 1 1
+f 1/1 2/1 3/1 4/1 5/1
+f 1/2 3/1 2/1
+`))
+	assert.NoError(t, err)
+
+	indexed, err := m.Indexed()
+	assert.NoError(t, err)
+	assert.Len(t, indexed.Positions, 6)
+	assert.Equal(t, indexed.Positions[0], indexed.Positions[5])
+	assert.Equal(t, vector2.New(1., 1.), indexed.TexCoords[5])
+	assert.Empty(t, indexed.Normals)
+	assert.Equal(t, []int{0, 1, 2, 0, 2, 3, 0, 3, 4, 5, 2, 1}, indexed.Indices)
+}
+
+func TestIndexed_PartialNormals(t *testing.T) {
+	m, err := obj.Read(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nvn 0 0 1\nf 1//1 2 3\n"))
+	assert.NoError(t, err)
+
+	indexed, err := m.Indexed()
+	assert.NoError(t, err)
+	assert.Empty(t, indexed.TexCoords)
+	assert.Equal(t, vector3.Float64Array{vector3.Forward[float64](), vector3.Zero[float64](), vector3.Zero[float64]()}, indexed.Normals)
+}
+
+func TestIndexedMesh_Mesh(t *testing.T) {
+	m, err := obj.Read(strings.NewReader(quad))
+	assert.NoError(t, err)
+	indexed, err := m.Indexed()
+	assert.NoError(t, err)
+
+	back, err := indexed.Mesh()
+	assert.NoError(t, err)
+	assert.Equal(t, indexed.Positions, back.Vertices)
+	assert.Equal(t, indexed.TexCoords, back.TexCoords)
+	assert.Equal(t, indexed.Normals, back.Normals)
+	assert.Equal(t, []obj.Face{
+		{{Vertex: 0, TexCoord: 0, Normal: 0}, {Vertex: 1, TexCoord: 1, Normal: 1}, {Vertex: 2, TexCoord: 2, Normal: 2}},
+		{{Vertex: 0, TexCoord: 0, Normal: 0}, {Vertex: 2, TexCoord: 2, Normal: 2}, {Vertex: 3, TexCoord: 3, Normal: 3}},
+	}, back.Faces)
+
+	again, err := back.Indexed()
+	assert.NoError(t, err)
+	assert.Equal(t, indexed, again)
+
+	positionsOnly, err := obj.IndexedMesh{Positions: indexed.Positions, Indices: []int{0, 1, 2}}.Mesh()
+	assert.NoError(t, err)
+	assert.Equal(t, []obj.Face{{{Vertex: 0, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: -1, Normal: -1}, {Vertex: 2, TexCoord: -1, Normal: -1}}}, positionsOnly.Faces)
+}
+
+func TestIndexedMesh_Mesh_Errors(t *testing.T) {
+	positions := vector3.Float64Array{vector3.Zero[float64](), vector3.One[float64]()}
+	tests := map[string]struct {
+		indexed obj.IndexedMesh
+		err     string
+	}{
+		"indices": {
+			indexed: obj.IndexedMesh{Positions: positions, Indices: []int{0, 1}},
+			err:     "index count 2 is not a multiple of 3",
+		},
+		"out of range": {
+			indexed: obj.IndexedMesh{Positions: positions, Indices: []int{0, 1, 2}},
+			err:     "index 2 references vertex 2, but there are only 2 vertices",
+		},
+		"tex coords": {
+			indexed: obj.IndexedMesh{Positions: positions, TexCoords: vector2.Float64Array{vector2.Zero[float64]()}},
+			err:     "indexed mesh has 1 texture coordinates, expected 2",
+		},
+		"normals": {
+			indexed: obj.IndexedMesh{Positions: positions, Normals: positions[:1]},
+			err:     "indexed mesh has 1 normals, expected 2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.indexed.Mesh()
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Package obj reads and writes the vertex data of Wavefront OBJ files:
+// positions, texture coordinates, normals, and the faces built from them.
+// Materials, groups, and other statements are ignored when reading.
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Corner is a single corner of a face, holding zero based indices into the
+// vertex data of a mesh. TexCoord and Normal are -1 when the corner doesn't
+// reference one.
+type Corner struct {
+	Vertex   int
+	TexCoord int
+	Normal   int
+}
+
+// Face is a polygon with three or more corners
+type Face []Corner
+
+// Mesh is the vertex data of an OBJ file
+type Mesh struct {
+	Vertices  vector3.Float64Array
+	TexCoords vector2.Float64Array
+	Normals   vector3.Float64Array
+	Faces     []Face
+}
+
+// Read reads the positions (v), texture coordinates (vt), normals (vn), and
+// faces (f) of an OBJ file. Negative face indices, which count backwards from
+// the most recently declared data, are resolved to absolute indices.
+func Read(in io.Reader) (Mesh, error) {
+	m := Mesh{}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	lineNumber := 0
+	var continued strings.Builder
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		// A trailing backslash joins the line with the next one
+		line = strings.TrimRight(line, " \t\r")
+		if strings.HasSuffix(line, "\\") {
+			continued.WriteString(line[:len(line)-1])
+			continued.WriteByte(' ')
+			continue
+		}
+		if continued.Len() > 0 {
+			continued.WriteString(line)
+			line = continued.String()
+			continued.Reset()
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if err := m.readStatement(fields); err != nil {
+			return Mesh{}, fmt.Errorf("obj line %d: %w", lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Mesh{}, err
+	}
+	return m, nil
+}
+
+func parseFloats(fields []string, lo, hi int) ([]float64, error) {
+	if len(fields) < lo || len(fields) > hi {
+		return nil, fmt.Errorf("expected between %d and %d values, found %d", lo, hi, len(fields))
+	}
+	out := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number: %q", f)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (m *Mesh) readStatement(fields []string) error {
+	switch fields[0] {
+	case "v":
+		// Extra values hold either a weight or a vertex color, and are
+		// ignored
+		values, err := parseFloats(fields[1:], 3, 6)
+		if err != nil {
+			return err
+		}
+		m.Vertices = append(m.Vertices, vector3.New(values[0], values[1], values[2]))
+
+	case "vt":
+		values, err := parseFloats(fields[1:], 1, 3)
+		if err != nil {
+			return err
+		}
+		values = append(values, 0)
+		m.TexCoords = append(m.TexCoords, vector2.New(values[0], values[1]))
+
+	case "vn":
+		values, err := parseFloats(fields[1:], 3, 3)
+		if err != nil {
+			return err
+		}
+		m.Normals = append(m.Normals, vector3.New(values[0], values[1], values[2]))
+
+	case "f":
+		if len(fields) < 4 {
+			return fmt.Errorf("face has %d corners, at least 3 are required", len(fields)-1)
+		}
+		face := make(Face, len(fields)-1)
+		for i, f := range fields[1:] {
+			corner, err := m.parseCorner(f)
+			if err != nil {
+				return err
+			}
+			face[i] = corner
+		}
+		m.Faces = append(m.Faces, face)
+	}
+	return nil
+}
+
+// resolveIndex converts a one based, or negative relative, OBJ index into a
+// zero based index into data of the length provided
+func resolveIndex(s string, length int, name, plural string) (int, error) {
+	index, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s index: %q", name, s)
+	}
+	resolved := index - 1
+	if index < 0 {
+		resolved = length + index
+	}
+	if index == 0 || resolved < 0 || resolved >= length {
+		return 0, fmt.Errorf("%s index %d out of range for %d %s", name, index, length, plural)
+	}
+	return resolved, nil
+}
+
+// parseCorner parses a face corner written as v, v/vt, v//vn, or v/vt/vn
+func (m *Mesh) parseCorner(s string) (Corner, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) > 3 {
+		return Corner{}, fmt.Errorf("invalid face corner: %q", s)
+	}
+
+	corner := Corner{TexCoord: -1, Normal: -1}
+	var err error
+	if corner.Vertex, err = resolveIndex(parts[0], len(m.Vertices), "vertex", "vertices"); err != nil {
+		return Corner{}, err
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		if corner.TexCoord, err = resolveIndex(parts[1], len(m.TexCoords), "texture coordinate", "texture coordinates"); err != nil {
+			return Corner{}, err
+		}
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		if corner.Normal, err = resolveIndex(parts[2], len(m.Normals), "normal", "normals"); err != nil {
+			return Corner{}, err
+		}
+	}
+	return corner, nil
+}
+
+func (m Mesh) validate() error {
+	for i, face := range m.Faces {
+		if len(face) < 3 {
+			return fmt.Errorf("face %d has %d corners, at least 3 are required", i, len(face))
+		}
+		for _, c := range face {
+			if c.Vertex < 0 || c.Vertex >= len(m.Vertices) {
+				return fmt.Errorf("face %d references vertex %d, but there are only %d vertices", i, c.Vertex, len(m.Vertices))
+			}
+			if c.TexCoord < -1 || c.TexCoord >= len(m.TexCoords) {
+				return fmt.Errorf("face %d references texture coordinate %d, but there are only %d texture coordinates", i, c.TexCoord, len(m.TexCoords))
+			}
+			if c.Normal < -1 || c.Normal >= len(m.Normals) {
+				return fmt.Errorf("face %d references normal %d, but there are only %d normals", i, c.Normal, len(m.Normals))
+			}
+		}
+	}
+	return nil
+}
+
+func appendFloats(buf []byte, prefix string, values ...float64) []byte {
+	buf = append(buf, prefix...)
+	for _, v := range values {
+		buf = append(buf, ' ')
+		buf = strconv.AppendFloat(buf, v, 'g', -1, 64)
+	}
+	return append(buf, '\n')
+}
+
+// Write writes the mesh as an OBJ file
+func (m Mesh) Write(out io.Writer) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(out)
+	var buf []byte
+	for _, v := range m.Vertices {
+		buf = appendFloats(buf[:0], "v", v.X(), v.Y(), v.Z())
+		bw.Write(buf)
+	}
+	for _, vt := range m.TexCoords {
+		buf = appendFloats(buf[:0], "vt", vt.X(), vt.Y())
+		bw.Write(buf)
+	}
+	for _, vn := range m.Normals {
+		buf = appendFloats(buf[:0], "vn", vn.X(), vn.Y(), vn.Z())
+		bw.Write(buf)
+	}
+
+	for _, face := range m.Faces {
+		buf = append(buf[:0], 'f')
+		for _, c := range face {
+			buf = append(buf, ' ')
+			buf = strconv.AppendInt(buf, int64(c.Vertex+1), 10)
+			if c.TexCoord >= 0 || c.Normal >= 0 {
+				buf = append(buf, '/')
+			}
+			if c.TexCoord >= 0 {
+				buf = strconv.AppendInt(buf, int64(c.TexCoord+1), 10)
+			}
+			if c.Normal >= 0 {
+				buf = append(buf, '/')
+				buf = strconv.AppendInt(buf, int64(c.Normal+1), 10)
+			}
+		}
+		buf = append(buf, '\n')
+		bw.Write(buf)
+	}
+	return bw.Flush()
+}
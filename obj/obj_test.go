@@ -0,0 +1,161 @@
+package obj_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/obj"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+const quad = `# A quad split in two
+mtllib quad.mtl
+o Quad
+v 0 0 0
+v 1 0 0 1
+v 1 1 0 0.5 0.5 0.5
+v 0 1 0
+vt 0 0
+vt 1 0
+vt 1 1
+vt 0.5
+vn 0 0 1
+usemtl Default
+s off
+f 1/1/1 2/2/1 3/3/1
+f -4/-4/-1 -2/-2/-1 \
+  -1/-1/-1
+`
+
+func TestRead(t *testing.T) {
+	m, err := obj.Read(strings.NewReader(quad))
+	assert.NoError(t, err)
+
+	assert.Equal(t, vector3.Float64Array{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(1., 1., 0.),
+		vector3.New(0., 1., 0.),
+	}, m.Vertices)
+	assert.Equal(t, vector2.Float64Array{
+		vector2.New(0., 0.),
+		vector2.New(1., 0.),
+		vector2.New(1., 1.),
+		vector2.New(0.5, 0.),
+	}, m.TexCoords)
+	assert.Equal(t, vector3.Float64Array{vector3.Forward[float64]()}, m.Normals)
+	assert.Equal(t, []obj.Face{
+		{{Vertex: 0, TexCoord: 0, Normal: 0}, {Vertex: 1, TexCoord: 1, Normal: 0}, {Vertex: 2, TexCoord: 2, Normal: 0}},
+		{{Vertex: 0, TexCoord: 0, Normal: 0}, {Vertex: 2, TexCoord: 2, Normal: 0}, {Vertex: 3, TexCoord: 3, Normal: 0}},
+	}, m.Faces)
+}
+
+func TestRead_CornerFormats(t *testing.T) {
+	m, err := obj.Read(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nvt 0 0\nvn 0 0 1\nf 1 2/1 3//1\nf 1/1/ 2/1/1 3\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []obj.Face{
+		{{Vertex: 0, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: 0, Normal: -1}, {Vertex: 2, TexCoord: -1, Normal: 0}},
+		{{Vertex: 0, TexCoord: 0, Normal: -1}, {Vertex: 1, TexCoord: 0, Normal: 0}, {Vertex: 2, TexCoord: -1, Normal: -1}},
+	}, m.Faces)
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		err  string
+	}{
+		"bad number":       {data: "v 0 zero 0\n", err: `obj line 1: invalid number: "zero"`},
+		"too few values":   {data: "\n\nv 0 0\n", err: "obj line 3: expected between 3 and 6 values, found 2"},
+		"too many normals": {data: "vn 0 0 1 0\n", err: "obj line 1: expected between 3 and 3 values, found 4"},
+		"small face":       {data: "v 0 0 0\nf 1 1\n", err: "obj line 2: face has 2 corners, at least 3 are required"},
+		"zero index":       {data: "v 0 0 0\nf 0 1 1\n", err: "obj line 2: vertex index 0 out of range for 1 vertices"},
+		"future index":     {data: "v 0 0 0\nf 1 1 2\nv 0 0 0\n", err: "obj line 2: vertex index 2 out of range for 1 vertices"},
+		"relative index":   {data: "v 0 0 0\nf 1 1 -2\n", err: "obj line 2: vertex index -2 out of range for 1 vertices"},
+		"bad index":        {data: "v 0 0 0\nf 1 1 a\n", err: `obj line 2: invalid vertex index: "a"`},
+		"bad tex coord":    {data: "v 0 0 0\nf 1 1 1/2\n", err: "obj line 2: texture coordinate index 2 out of range for 0 texture coordinates"},
+		"bad normal":       {data: "v 0 0 0\nf 1 1 1//1\n", err: "obj line 2: normal index 1 out of range for 0 normals"},
+		"too many slashes": {data: "v 0 0 0\nf 1 1 1/1/1/1\n", err: `obj line 2: invalid face corner: "1/1/1/1"`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := obj.Read(strings.NewReader(tc.data))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+func TestRead_ReaderError(t *testing.T) {
+	_, err := obj.Read(failingReader{})
+	assert.EqualError(t, err, "disk on fire")
+}
+
+func TestWrite(t *testing.T) {
+	m := obj.Mesh{
+		Vertices:  vector3.Float64Array{vector3.New(0., 0., 0.), vector3.New(1.5, 0., -2.), vector3.New(0.1, 1., 0.)},
+		TexCoords: vector2.Float64Array{vector2.New(0.25, 0.75)},
+		Normals:   vector3.Float64Array{vector3.New(0., 0., 1.)},
+		Faces: []obj.Face{
+			{{Vertex: 0, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: 0, Normal: -1}, {Vertex: 2, TexCoord: -1, Normal: 0}},
+			{{Vertex: 2, TexCoord: 0, Normal: 0}, {Vertex: 1, TexCoord: 0, Normal: 0}, {Vertex: 0, TexCoord: 0, Normal: 0}},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf))
+	assert.Equal(t, `v 0 0 0
+v 1.5 0 -2
+v 0.1 1 0
+vt 0.25 0.75
+vn 0 0 1
+f 1 2/1 3//1
+f 3/1/1 2/1/1 1/1/1
+`, buf.String())
+
+	back, err := obj.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, m, back)
+}
+
+func TestWrite_Errors(t *testing.T) {
+	vertices := vector3.Float64Array{vector3.Zero[float64](), vector3.One[float64]()}
+	tests := map[string]struct {
+		mesh obj.Mesh
+		err  string
+	}{
+		"small face": {
+			mesh: obj.Mesh{Vertices: vertices, Faces: []obj.Face{{{Vertex: 0}, {Vertex: 1}}}},
+			err:  "face 0 has 2 corners, at least 3 are required",
+		},
+		"vertex": {
+			mesh: obj.Mesh{Vertices: vertices, Faces: []obj.Face{{{Vertex: 0, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: -1, Normal: -1}, {Vertex: 2, TexCoord: -1, Normal: -1}}}},
+			err:  "face 0 references vertex 2, but there are only 2 vertices",
+		},
+		"tex coord": {
+			mesh: obj.Mesh{Vertices: vertices, Faces: []obj.Face{{{Vertex: 0, TexCoord: 0, Normal: -1}, {Vertex: 1, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: -1, Normal: -1}}}},
+			err:  "face 0 references texture coordinate 0, but there are only 0 texture coordinates",
+		},
+		"normal": {
+			mesh: obj.Mesh{Vertices: vertices, Faces: []obj.Face{{{Vertex: 0, TexCoord: -1, Normal: -2}, {Vertex: 1, TexCoord: -1, Normal: -1}, {Vertex: 1, TexCoord: -1, Normal: -1}}}},
+			err:  "face 0 references normal -2, but there are only 0 normals",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, tc.mesh.Write(&bytes.Buffer{}), tc.err)
+			_, err := tc.mesh.Indexed()
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
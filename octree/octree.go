@@ -0,0 +1,339 @@
+// Package octree provides a dynamic octree for spatially partitioning vector3
+// points and bounding boxes.
+package octree
+
+import (
+	"sort"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// RayHit is an item intersected by a ray, along with the distance along the
+// ray at which it was first hit
+type RayHit struct {
+	ID       int
+	Distance float64
+}
+
+type entry[T vector.Number] struct {
+	id     int
+	box    vector3.AABB[T]
+	bounds vector3.AABB[float64]
+	node   *node[T]
+}
+
+type node[T vector.Number] struct {
+	bounds   vector3.AABB[float64]
+	depth    int
+	parent   *node[T]
+	children []*node[T]
+	entries  []*entry[T]
+
+	// count is the number of entries within this node and all of its
+	// descendants
+	count int
+}
+
+// childContaining returns the child that entirely contains the bounds
+// provided, or nil if the bounds straddle multiple children or lie outside
+// of the node
+func (n *node[T]) childContaining(b vector3.AABB[float64]) *node[T] {
+	if !n.bounds.ContainsAABB(b) {
+		return nil
+	}
+
+	center := n.bounds.Center()
+	min := b.Min().ToFixedArr()
+	max := b.Max().ToFixedArr()
+	c := center.ToFixedArr()
+
+	index := 0
+	for axis := 0; axis < 3; axis++ {
+		if min[axis] >= c[axis] && max[axis] > c[axis] {
+			index |= 1 << axis
+		} else if max[axis] > c[axis] {
+			return nil
+		}
+	}
+	return n.children[index]
+}
+
+func (n *node[T]) removeEntry(e *entry[T]) {
+	for i, other := range n.entries {
+		if other == e {
+			last := len(n.entries) - 1
+			n.entries[i] = n.entries[last]
+			n.entries[last] = nil
+			n.entries = n.entries[:last]
+			return
+		}
+	}
+}
+
+// Octree stores items identified by an integer ID. Each item is kept in the
+// deepest node that entirely contains its bounding box, and nodes are split
+// into 8 children once they hold more than the capacity. Items that fall
+// outside of the tree's bounds are kept at the root.
+type Octree[T vector.Number] struct {
+	root     *node[T]
+	entries  map[int]*entry[T]
+	capacity int
+	maxDepth int
+}
+
+// New creates an empty octree covering the bounds provided. Nodes split once
+// they contain more than capacity items, up until maxDepth.
+func New[T vector.Number](bounds vector3.AABB[T], capacity, maxDepth int) *Octree[T] {
+	return &Octree[T]{
+		root:     &node[T]{bounds: bounds.ToFloat64()},
+		entries:  make(map[int]*entry[T]),
+		capacity: max(1, capacity),
+		maxDepth: maxDepth,
+	}
+}
+
+// Len returns the number of items within the tree
+func (o *Octree[T]) Len() int {
+	return len(o.entries)
+}
+
+// Bounds returns the bounding box of the item with the ID provided
+func (o *Octree[T]) Bounds(id int) (vector3.AABB[T], bool) {
+	e, ok := o.entries[id]
+	if !ok {
+		return vector3.AABB[T]{}, false
+	}
+	return e.box, true
+}
+
+// Insert adds an item with the bounding box provided to the tree. If an item
+// with the same ID already exists, it is replaced.
+func (o *Octree[T]) Insert(id int, box vector3.AABB[T]) {
+	if _, ok := o.entries[id]; ok {
+		o.Remove(id)
+	}
+
+	e := &entry[T]{id: id, box: box, bounds: box.ToFloat64()}
+	o.entries[id] = e
+	o.insert(o.root, e)
+}
+
+// InsertPoint adds an item located at a single point to the tree
+func (o *Octree[T]) InsertPoint(id int, p vector3.Vector[T]) {
+	o.Insert(id, vector3.NewAABB(p, p))
+}
+
+func (o *Octree[T]) insert(n *node[T], e *entry[T]) {
+	for {
+		n.count++
+
+		if n.children == nil {
+			n.entries = append(n.entries, e)
+			e.node = n
+			if len(n.entries) > o.capacity && n.depth < o.maxDepth {
+				o.split(n)
+			}
+			return
+		}
+
+		child := n.childContaining(e.bounds)
+		if child == nil {
+			n.entries = append(n.entries, e)
+			e.node = n
+			return
+		}
+		n = child
+	}
+}
+
+func (o *Octree[T]) split(n *node[T]) {
+	center := n.bounds.Center()
+	min := n.bounds.Min()
+	max := n.bounds.Max()
+
+	n.children = make([]*node[T], 8)
+	for i := range n.children {
+		lo := min
+		hi := center
+		if i&1 != 0 {
+			lo = lo.SetX(center.X())
+			hi = hi.SetX(max.X())
+		}
+		if i&2 != 0 {
+			lo = lo.SetY(center.Y())
+			hi = hi.SetY(max.Y())
+		}
+		if i&4 != 0 {
+			lo = lo.SetZ(center.Z())
+			hi = hi.SetZ(max.Z())
+		}
+		n.children[i] = &node[T]{
+			bounds: vector3.NewAABB(lo, hi),
+			depth:  n.depth + 1,
+			parent: n,
+		}
+	}
+
+	entries := n.entries
+	n.entries = nil
+	for _, e := range entries {
+		child := n.childContaining(e.bounds)
+		if child == nil {
+			n.entries = append(n.entries, e)
+			e.node = n
+			continue
+		}
+		child.count++
+		child.entries = append(child.entries, e)
+		e.node = child
+	}
+
+	for _, child := range n.children {
+		if len(child.entries) > o.capacity && child.depth < o.maxDepth {
+			o.split(child)
+		}
+	}
+}
+
+// Remove deletes the item with the ID provided from the tree, returning
+// whether or not it was present
+func (o *Octree[T]) Remove(id int) bool {
+	e, ok := o.entries[id]
+	if !ok {
+		return false
+	}
+	delete(o.entries, id)
+
+	e.node.removeEntry(e)
+
+	// Walk back up the tree, finding the highest node that no longer needs
+	// to be split
+	var collapse *node[T]
+	for n := e.node; n != nil; n = n.parent {
+		n.count--
+		if n.children != nil && n.count <= o.capacity {
+			collapse = n
+		}
+	}
+
+	if collapse != nil {
+		o.collapse(collapse)
+	}
+	return true
+}
+
+// collapse pulls all entries of the node's descendants into the node itself
+func (o *Octree[T]) collapse(n *node[T]) {
+	var gather func(child *node[T])
+	gather = func(child *node[T]) {
+		for _, e := range child.entries {
+			e.node = n
+			n.entries = append(n.entries, e)
+		}
+		for _, grandchild := range child.children {
+			gather(grandchild)
+		}
+	}
+
+	for _, child := range n.children {
+		gather(child)
+	}
+	n.children = nil
+}
+
+// Move changes the bounding box of the item with the ID provided, returning
+// whether or not it was present
+func (o *Octree[T]) Move(id int, box vector3.AABB[T]) bool {
+	e, ok := o.entries[id]
+	if !ok {
+		return false
+	}
+
+	// If the item still belongs to the same node, there's no need to
+	// restructure the tree
+	bounds := box.ToFloat64()
+	n := e.node
+	if (n == o.root || n.bounds.ContainsAABB(bounds)) && (n.children == nil || n.childContaining(bounds) == nil) {
+		e.box = box
+		e.bounds = bounds
+		return true
+	}
+
+	o.Remove(id)
+	o.Insert(id, box)
+	return true
+}
+
+// MovePoint changes the location of the item with the ID provided to a single
+// point, returning whether or not it was present
+func (o *Octree[T]) MovePoint(id int, p vector3.Vector[T]) bool {
+	return o.Move(id, vector3.NewAABB(p, p))
+}
+
+// query visits every entry of every node whose bounds pass the filter. The
+// root is always visited, as it holds entries that fall outside of its bounds
+func (o *Octree[T]) query(filter func(b vector3.AABB[float64]) bool, visit func(e *entry[T])) {
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		for _, e := range n.entries {
+			if filter(e.bounds) {
+				visit(e)
+			}
+		}
+		for _, child := range n.children {
+			if child.count > 0 && filter(child.bounds) {
+				walk(child)
+			}
+		}
+	}
+	walk(o.root)
+}
+
+// QueryAABB returns the IDs of all items whose bounding boxes intersect the
+// bounding box provided, in no particular order
+func (o *Octree[T]) QueryAABB(box vector3.AABB[T]) []int {
+	query := box.ToFloat64()
+	out := make([]int, 0)
+	o.query(query.Intersects, func(e *entry[T]) {
+		out = append(out, e.id)
+	})
+	return out
+}
+
+// QuerySphere returns the IDs of all items whose bounding boxes intersect the
+// sphere provided, in no particular order
+func (o *Octree[T]) QuerySphere(center vector3.Vector[T], radius float64) []int {
+	c := center.ToFloat64()
+	out := make([]int, 0)
+	o.query(
+		func(b vector3.AABB[float64]) bool { return b.IntersectsSphere(c, radius) },
+		func(e *entry[T]) { out = append(out, e.id) },
+	)
+	return out
+}
+
+// Raycast returns every item whose bounding box is intersected by the ray
+// within maxDistance, ordered from nearest to farthest. Distances are in
+// units of the direction's length.
+func (o *Octree[T]) Raycast(origin, direction vector3.Float64, maxDistance float64) []RayHit {
+	hits := make([]RayHit, 0)
+	o.query(
+		func(b vector3.AABB[float64]) bool {
+			tMin, _, hit := b.IntersectRay(origin, direction)
+			return hit && tMin <= maxDistance
+		},
+		func(e *entry[T]) {
+			tMin, _, _ := e.bounds.IntersectRay(origin, direction)
+			hits = append(hits, RayHit{ID: e.id, Distance: max(tMin, 0)})
+		},
+	)
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Distance == hits[j].Distance {
+			return hits[i].ID < hits[j].ID
+		}
+		return hits[i].Distance < hits[j].Distance
+	})
+	return hits
+}
@@ -0,0 +1,156 @@
+package octree_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/octree"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomBox(r *rand.Rand) vector3.AABB[float64] {
+	center := vector3.RandRange(r, -110., 110.)
+	size := vector3.RandRange(r, 0., 8.)
+	if r.Intn(3) == 0 {
+		size = vector3.Zero[float64]()
+	}
+	return vector3.NewAABBFromCenter(center, size)
+}
+
+func bruteForce(boxes map[int]vector3.AABB[float64], test func(vector3.AABB[float64]) bool) []int {
+	out := []int{}
+	for id, b := range boxes {
+		if test(b) {
+			out = append(out, id)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func sorted(ids []int) []int {
+	sort.Ints(ids)
+	return ids
+}
+
+func TestOctree_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	tree := octree.New(vector3.NewAABB(vector3.Fill(-100.), vector3.Fill(100.)), 4, 8)
+	boxes := map[int]vector3.AABB[float64]{}
+
+	for i := 0; i < 1000; i++ {
+		box := randomBox(r)
+		boxes[i] = box
+		tree.Insert(i, box)
+	}
+
+	// Shuffle things around
+	for i := 0; i < 300; i++ {
+		id := r.Intn(1000)
+		if _, ok := boxes[id]; !ok {
+			assert.False(t, tree.Remove(id))
+			continue
+		}
+
+		switch r.Intn(3) {
+		case 0:
+			assert.True(t, tree.Remove(id))
+			delete(boxes, id)
+		case 1:
+			box := randomBox(r)
+			boxes[id] = box
+			assert.True(t, tree.Move(id, box))
+		case 2:
+			p := vector3.RandRange(r, -100., 100.)
+			boxes[id] = vector3.NewAABB(p, p)
+			assert.True(t, tree.MovePoint(id, p))
+		}
+	}
+	assert.Equal(t, len(boxes), tree.Len())
+
+	for id, box := range boxes {
+		got, ok := tree.Bounds(id)
+		assert.True(t, ok)
+		assert.Equal(t, box, got)
+	}
+
+	for i := 0; i < 50; i++ {
+		query := randomBox(r).Expand(10)
+		assert.Equal(t,
+			bruteForce(boxes, query.Intersects),
+			sorted(tree.QueryAABB(query)),
+		)
+
+		center := vector3.RandRange(r, -100., 100.)
+		radius := r.Float64() * 30
+		assert.Equal(t,
+			bruteForce(boxes, func(b vector3.AABB[float64]) bool { return b.IntersectsSphere(center, radius) }),
+			sorted(tree.QuerySphere(center, radius)),
+		)
+
+		origin := vector3.RandRange(r, -150., 150.)
+		direction := vector3.RandNormal(r)
+		maxDistance := r.Float64() * 300
+		want := bruteForce(boxes, func(b vector3.AABB[float64]) bool {
+			tMin, _, hit := b.IntersectRay(origin, direction)
+			return hit && tMin <= maxDistance
+		})
+
+		hits := tree.Raycast(origin, direction, maxDistance)
+		got := []int{}
+		for j, hit := range hits {
+			got = append(got, hit.ID)
+			if j > 0 {
+				assert.LessOrEqual(t, hits[j-1].Distance, hit.Distance)
+			}
+		}
+		assert.Equal(t, want, sorted(got))
+	}
+}
+
+func TestOctree_RemoveAllCollapses(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	tree := octree.New(vector3.NewAABB(vector3.Fill(-100.), vector3.Fill(100.)), 2, 10)
+
+	for i := 0; i < 500; i++ {
+		tree.InsertPoint(i, vector3.RandRange(r, -100., 100.))
+	}
+	for i := 0; i < 500; i++ {
+		assert.True(t, tree.Remove(i))
+	}
+
+	assert.Equal(t, 0, tree.Len())
+	assert.Empty(t, tree.QueryAABB(vector3.NewAABB(vector3.Fill(-1000.), vector3.Fill(1000.))))
+
+	_, ok := tree.Bounds(3)
+	assert.False(t, ok)
+	assert.False(t, tree.Move(3, vector3.AABB[float64]{}))
+}
+
+func TestOctree_InsertReplaces(t *testing.T) {
+	tree := octree.New(vector3.NewAABB(vector3.Fill(0), vector3.Fill(10)), 4, 4)
+	tree.InsertPoint(1, vector3.New(1, 1, 1))
+	tree.InsertPoint(1, vector3.New(9, 9, 9))
+
+	assert.Equal(t, 1, tree.Len())
+	assert.Empty(t, tree.QuerySphere(vector3.New(1, 1, 1), 1))
+	assert.Equal(t, []int{1}, tree.QuerySphere(vector3.New(9, 9, 9), 1))
+}
+
+func TestOctree_OutsideBounds(t *testing.T) {
+	tree := octree.New(vector3.NewAABB(vector3.Fill(0.), vector3.Fill(10.)), 1, 4)
+	tree.InsertPoint(1, vector3.New(1., 1., 1.))
+	tree.InsertPoint(2, vector3.New(2., 2., 2.))
+	tree.InsertPoint(3, vector3.New(50., 50., 50.))
+
+	assert.Equal(t, []int{3}, tree.QuerySphere(vector3.New(50., 50., 51.), 2))
+
+	hits := tree.Raycast(vector3.Zero[float64](), vector3.One[float64]().Normalized(), 1000)
+	assert.Len(t, hits, 3)
+	assert.Equal(t, 1, hits[0].ID)
+	assert.Equal(t, 2, hits[1].ID)
+	assert.Equal(t, 3, hits[2].ID)
+	assert.InDelta(t, vector3.Fill(50.).Length(), hits[2].Distance, 1e-9)
+}
@@ -0,0 +1,151 @@
+package ply
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// elementHeader is an element as declared in a PLY header
+type elementHeader struct {
+	name       string
+	count      int
+	properties []Property
+}
+
+type header struct {
+	format   Format
+	comments []string
+	objInfo  []string
+	elements []elementHeader
+}
+
+func readHeaderLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+func readHeader(in *bufio.Reader) (header, error) {
+	h := header{}
+
+	magic, err := readHeaderLine(in)
+	if err != nil {
+		return h, err
+	}
+	if strings.TrimSpace(magic) != "ply" {
+		return h, fmt.Errorf("not a ply file, started with %q", magic)
+	}
+
+	formatFound := false
+	for {
+		line, err := readHeaderLine(in)
+		if err != nil {
+			return h, err
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "end_header":
+			if !formatFound {
+				return h, fmt.Errorf("ply header is missing a format")
+			}
+			return h, nil
+
+		case "comment":
+			h.comments = append(h.comments, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "comment")))
+
+		case "obj_info":
+			h.objInfo = append(h.objInfo, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "obj_info")))
+
+		case "format":
+			if len(fields) != 3 {
+				return h, fmt.Errorf("invalid ply format line: %q", line)
+			}
+			format, err := parseFormat(fields[1])
+			if err != nil {
+				return h, err
+			}
+			if fields[2] != "1.0" {
+				return h, fmt.Errorf("unsupported ply version: %s", fields[2])
+			}
+			h.format = format
+			formatFound = true
+
+		case "element":
+			if len(fields) != 3 {
+				return h, fmt.Errorf("invalid ply element line: %q", line)
+			}
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return h, fmt.Errorf("invalid ply element count: %q", fields[2])
+			}
+			h.elements = append(h.elements, elementHeader{name: fields[1], count: count})
+
+		case "property":
+			if len(h.elements) == 0 {
+				return h, fmt.Errorf("ply property declared before any element: %q", line)
+			}
+			property, err := parseProperty(fields)
+			if err != nil {
+				return h, err
+			}
+			element := &h.elements[len(h.elements)-1]
+			for _, existing := range element.properties {
+				if existing.Name == property.Name {
+					return h, fmt.Errorf("ply element %s declares property %s twice", element.name, property.Name)
+				}
+			}
+			element.properties = append(element.properties, property)
+
+		default:
+			return h, fmt.Errorf("unrecognized ply header line: %q", line)
+		}
+	}
+}
+
+func parseProperty(fields []string) (Property, error) {
+	var p Property
+	switch {
+	case len(fields) == 3:
+		p = Property{Type: fields[1], Name: fields[2]}
+	case len(fields) == 5 && fields[1] == "list":
+		p = Property{CountType: fields[2], Type: fields[3], Name: fields[4]}
+	default:
+		return p, fmt.Errorf("invalid ply property line: %q", strings.Join(fields, " "))
+	}
+	return p, p.validate()
+}
+
+func writeHeader(out *bufio.Writer, h header) error {
+	fmt.Fprintf(out, "ply\nformat %s 1.0\n", h.format)
+	for _, c := range h.comments {
+		fmt.Fprintf(out, "comment %s\n", c)
+	}
+	for _, info := range h.objInfo {
+		fmt.Fprintf(out, "obj_info %s\n", info)
+	}
+	for _, e := range h.elements {
+		fmt.Fprintf(out, "element %s %d\n", e.name, e.count)
+		for _, p := range e.properties {
+			if p.IsList() {
+				fmt.Fprintf(out, "property list %s %s %s\n", p.CountType, p.Type, p.Name)
+			} else {
+				fmt.Fprintf(out, "property %s %s\n", p.Type, p.Name)
+			}
+		}
+	}
+	_, err := out.WriteString("end_header\n")
+	return err
+}
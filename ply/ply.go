@@ -0,0 +1,707 @@
+// Package ply reads and writes point clouds and meshes stored in the PLY
+// (polygon file) format, in both its ASCII and binary encodings.
+package ply
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+)
+
+// Format is the encoding used for the body of a PLY file
+type Format int
+
+const (
+	ASCII Format = iota
+	BinaryLittleEndian
+	BinaryBigEndian
+)
+
+func (f Format) String() string {
+	switch f {
+	case ASCII:
+		return "ascii"
+	case BinaryLittleEndian:
+		return "binary_little_endian"
+	case BinaryBigEndian:
+		return "binary_big_endian"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+func parseFormat(s string) (Format, error) {
+	for _, f := range []Format{ASCII, BinaryLittleEndian, BinaryBigEndian} {
+		if f.String() == s {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ply format: %s", s)
+}
+
+// Property describes a single property of an element as declared in a PLY
+// header. List properties have a CountType, which is the type used to store
+// the length of each list.
+type Property struct {
+	Name      string
+	Type      string
+	CountType string
+}
+
+// IsList determines whether or not the property holds a list of values
+// rather than a single value
+func (p Property) IsList() bool {
+	return p.CountType != ""
+}
+
+func (p Property) validate() error {
+	if p.Name == "" || strings.ContainsAny(p.Name, " \t\r\n") {
+		return fmt.Errorf("invalid ply property name: %q", p.Name)
+	}
+	if _, err := parseType(p.Type); err != nil {
+		return err
+	}
+	if p.IsList() {
+		countType, err := parseType(p.CountType)
+		if err != nil {
+			return err
+		}
+		if !countType.isInteger() {
+			return fmt.Errorf("ply list property %s has non integer count type %s", p.Name, p.CountType)
+		}
+	}
+	return nil
+}
+
+// PropertyData holds every value of a property that this package doesn't
+// interpret, so that it can be written back out unchanged. Scalar properties
+// populate Values, while list properties populate Lists.
+type PropertyData struct {
+	Property
+	Values []float64
+	Lists  [][]float64
+}
+
+func (pd PropertyData) len() int {
+	if pd.IsList() {
+		return len(pd.Lists)
+	}
+	return len(pd.Values)
+}
+
+// Element holds every instance of an element other than vertices and faces
+type Element struct {
+	Name       string
+	Count      int
+	Properties []PropertyData
+}
+
+// Mesh is the contents of a PLY file. Point clouds are simply meshes without
+// any faces.
+type Mesh struct {
+	Comments []string
+	ObjInfo  []string
+
+	// Vertices are read from the x, y, and z properties of the vertex
+	// element
+	Vertices vector3.Float64Array
+
+	// Normals are read from the nx, ny, and nz properties of the vertex
+	// element. Empty if the file has no normals.
+	Normals vector3.Float64Array
+
+	// Colors are read from the red, green, blue, and optional alpha
+	// properties of the vertex element, and normalized to [0, 1] with
+	// vector4.FromColor. Alpha is 1 when not present in the file. Empty if
+	// the file has no colors.
+	Colors vector4.Float64Array
+
+	// Alpha writes the W component of colors as an alpha property. Set when
+	// reading files that store colors with alpha.
+	Alpha bool
+
+	// ColorType is the type every color property is written as, defaulting
+	// to uchar when empty. Set to the type of the red property when reading
+	// files with colors.
+	ColorType string
+
+	// Faces hold the indices of the vertices making up each polygon, read
+	// from the vertex_indices (or vertex_index) property of the face element
+	Faces [][]int
+
+	// DoublePrecision writes positions and normals as doubles rather than
+	// floats. Set when reading files that store positions as doubles.
+	DoublePrecision bool
+
+	// VertexProperties and FaceProperties hold any other properties of the
+	// vertex and face elements
+	VertexProperties []PropertyData
+	FaceProperties   []PropertyData
+
+	// Elements holds any other elements
+	Elements []Element
+
+	// ElementOrder holds the names of the elements in the order they are
+	// written. Elements it doesn't mention follow in their default order of
+	// vertex, face, and then Elements. Set to the order of the header when
+	// reading files.
+	ElementOrder []string
+}
+
+// Read reads a PLY file of any format
+func Read(in io.Reader) (Mesh, error) {
+	br := bufio.NewReader(in)
+	h, err := readHeader(br)
+	if err != nil {
+		return Mesh{}, err
+	}
+
+	var values valueReader
+	switch h.format {
+	case ASCII:
+		values = &asciiReader{in: br}
+	case BinaryLittleEndian:
+		values = &binaryReader{in: br, endian: binary.LittleEndian}
+	case BinaryBigEndian:
+		values = &binaryReader{in: br, endian: binary.BigEndian}
+	}
+
+	m := Mesh{
+		Comments: h.comments,
+		ObjInfo:  h.objInfo,
+	}
+	for _, e := range h.elements {
+		m.ElementOrder = append(m.ElementOrder, e.name)
+
+		var err error
+		switch e.name {
+		case "vertex":
+			err = m.readVertices(values, e)
+		case "face":
+			err = m.readFaces(values, e)
+		default:
+			err = m.readElement(values, e)
+		}
+		if err != nil {
+			return Mesh{}, err
+		}
+	}
+
+	for i, face := range m.Faces {
+		for _, index := range face {
+			if index < 0 || index >= len(m.Vertices) {
+				return Mesh{}, fmt.Errorf("face %d references vertex %d, but there are only %d vertices", i, index, len(m.Vertices))
+			}
+		}
+	}
+	return m, nil
+}
+
+// propertyIndices maps each property name to its position within the
+// element
+func propertyIndices(e elementHeader) map[string]int {
+	indices := make(map[string]int, len(e.properties))
+	for i, p := range e.properties {
+		indices[p.Name] = i
+	}
+	return indices
+}
+
+// scalarIndices returns the positions of every named scalar property, and
+// whether or not they were all present
+func scalarIndices(e elementHeader, indices map[string]int, names ...string) ([]int, bool) {
+	out := make([]int, len(names))
+	for i, name := range names {
+		index, ok := indices[name]
+		if !ok || e.properties[index].IsList() {
+			return nil, false
+		}
+		out[i] = index
+	}
+	return out, true
+}
+
+// readInstances reads every instance of the element, passing the values of
+// each to visit. Scalar values are found in scalars, and lists are found in
+// lists, both indexed by property. Both slices are reused between instances.
+func readInstances(in valueReader, e elementHeader, visit func(scalars []float64, lists [][]float64)) error {
+	types := make([]scalarType, len(e.properties))
+	countTypes := make([]scalarType, len(e.properties))
+	for i, p := range e.properties {
+		types[i], _ = parseType(p.Type)
+		countTypes[i], _ = parseType(p.CountType)
+	}
+
+	scalars := make([]float64, len(e.properties))
+	lists := make([][]float64, len(e.properties))
+	for i := 0; i < e.count; i++ {
+		for j, p := range e.properties {
+			if !p.IsList() {
+				v, err := in.read(types[j])
+				if err != nil {
+					return fmt.Errorf("ply element %s %d property %s: %w", e.name, i, p.Name, err)
+				}
+				scalars[j] = v
+				continue
+			}
+
+			count, err := in.read(countTypes[j])
+			if err == nil && count < 0 {
+				err = fmt.Errorf("negative list length %g", count)
+			}
+			if err != nil {
+				return fmt.Errorf("ply element %s %d property %s: %w", e.name, i, p.Name, err)
+			}
+			lists[j] = lists[j][:0]
+			for k := 0; k < int(count); k++ {
+				v, err := in.read(types[j])
+				if err != nil {
+					return fmt.Errorf("ply element %s %d property %s: %w", e.name, i, p.Name, err)
+				}
+				lists[j] = append(lists[j], v)
+			}
+		}
+		visit(scalars, lists)
+	}
+	return nil
+}
+
+// extraProperties builds storage for every property not in reserved
+func extraProperties(e elementHeader, reserved func(index int) bool) ([]PropertyData, []int) {
+	var extras []PropertyData
+	var indices []int
+	for i, p := range e.properties {
+		if !reserved(i) {
+			extras = append(extras, PropertyData{Property: p})
+			indices = append(indices, i)
+		}
+	}
+	return extras, indices
+}
+
+func appendExtras(extras []PropertyData, indices []int, scalars []float64, lists [][]float64) {
+	for i, index := range indices {
+		if extras[i].IsList() {
+			extras[i].Lists = append(extras[i].Lists, append(make([]float64, 0, len(lists[index])), lists[index]...))
+		} else {
+			extras[i].Values = append(extras[i].Values, scalars[index])
+		}
+	}
+}
+
+// colorChannel converts a color value stored as the type to a channel of a
+// color.RGBA64. Bytes and shorts map directly onto 8 and 16 bit channels,
+// while other integer types are scaled from their full range, and floating
+// point values are expected to already be within [0, 1].
+func colorChannel(t scalarType, v float64) uint16 {
+	switch t {
+	case typeUchar:
+		return uint16(v) * 0x101
+	case typeUshort:
+		return uint16(v)
+	}
+	if t.isInteger() {
+		v /= t.maxValue()
+	}
+	return uint16(math.Round(math.Max(0, math.Min(1, v)) * math.MaxUint16))
+}
+
+// colorValue converts a color channel within [0, 1] to a value stored as the
+// type, the inverse of colorChannel
+func colorValue(t scalarType, c float64) float64 {
+	if math.IsNaN(c) {
+		return 0
+	}
+	c = math.Max(0, math.Min(1, c))
+	if t.isInteger() {
+		return math.Round(c * t.maxValue())
+	}
+	return c
+}
+
+func (m *Mesh) readVertices(in valueReader, e elementHeader) error {
+	indices := propertyIndices(e)
+	position, ok := scalarIndices(e, indices, "x", "y", "z")
+	if !ok {
+		return fmt.Errorf("ply vertex element is missing scalar x, y, and z properties")
+	}
+	m.DoublePrecision = e.properties[position[0]].Type == "double" || e.properties[position[0]].Type == "float64"
+
+	normal, hasNormals := scalarIndices(e, indices, "nx", "ny", "nz")
+	colorIndices, hasColors := scalarIndices(e, indices, "red", "green", "blue")
+	alpha, hasAlpha := scalarIndices(e, indices, "alpha")
+	hasAlpha = hasAlpha && hasColors
+
+	// Properties of partially present groups are passed through untouched
+	reserved := make(map[int]bool)
+	for _, group := range [][]int{position, normal, colorIndices} {
+		for _, index := range group {
+			reserved[index] = true
+		}
+	}
+	if hasAlpha {
+		reserved[alpha[0]] = true
+	}
+	extras, extraIndices := extraProperties(e, func(index int) bool {
+		return reserved[index]
+	})
+
+	var channelTypes [4]scalarType
+	if hasColors {
+		for i, index := range colorIndices {
+			channelTypes[i], _ = parseType(e.properties[index].Type)
+		}
+	}
+	if hasColors {
+		m.ColorType = channelTypes[0].String()
+	}
+	if hasAlpha {
+		channelTypes[3], _ = parseType(e.properties[alpha[0]].Type)
+	}
+	m.Alpha = hasAlpha
+
+	// Grow gradually rather than trusting the header with one huge
+	// allocation, in case the data is corrupt or truncated
+	capacity := min(e.count, 1<<16)
+	m.Vertices = make(vector3.Float64Array, 0, capacity)
+	if hasNormals {
+		m.Normals = make(vector3.Float64Array, 0, capacity)
+	}
+	if hasColors {
+		m.Colors = make(vector4.Float64Array, 0, capacity)
+	}
+
+	err := readInstances(in, e, func(scalars []float64, lists [][]float64) {
+		m.Vertices = append(m.Vertices, vector3.New(scalars[position[0]], scalars[position[1]], scalars[position[2]]))
+		if hasNormals {
+			m.Normals = append(m.Normals, vector3.New(scalars[normal[0]], scalars[normal[1]], scalars[normal[2]]))
+		}
+		if hasColors {
+			c := color.RGBA64{
+				R: colorChannel(channelTypes[0], scalars[colorIndices[0]]),
+				G: colorChannel(channelTypes[1], scalars[colorIndices[1]]),
+				B: colorChannel(channelTypes[2], scalars[colorIndices[2]]),
+				A: math.MaxUint16,
+			}
+			if hasAlpha {
+				c.A = colorChannel(channelTypes[3], scalars[alpha[0]])
+			}
+			m.Colors = append(m.Colors, vector4.FromColor(c))
+		}
+		appendExtras(extras, extraIndices, scalars, lists)
+	})
+	m.VertexProperties = extras
+	return err
+}
+
+func (m *Mesh) readFaces(in valueReader, e elementHeader) error {
+	indices := propertyIndices(e)
+	faceIndex, ok := indices["vertex_indices"]
+	if !ok {
+		faceIndex, ok = indices["vertex_index"]
+	}
+	if !ok || !e.properties[faceIndex].IsList() {
+		return fmt.Errorf("ply face element is missing a vertex_indices list property")
+	}
+	if t, _ := parseType(e.properties[faceIndex].Type); !t.isInteger() {
+		return fmt.Errorf("ply face vertex indices have non integer type %s", e.properties[faceIndex].Type)
+	}
+
+	extras, extraIndices := extraProperties(e, func(index int) bool {
+		return index == faceIndex
+	})
+
+	m.Faces = make([][]int, 0, min(e.count, 1<<16))
+	err := readInstances(in, e, func(scalars []float64, lists [][]float64) {
+		face := make([]int, len(lists[faceIndex]))
+		for i, v := range lists[faceIndex] {
+			face[i] = int(v)
+		}
+		m.Faces = append(m.Faces, face)
+		appendExtras(extras, extraIndices, scalars, lists)
+	})
+	m.FaceProperties = extras
+	return err
+}
+
+func (m *Mesh) readElement(in valueReader, e elementHeader) error {
+	extras, extraIndices := extraProperties(e, func(int) bool { return false })
+	err := readInstances(in, e, func(scalars []float64, lists [][]float64) {
+		appendExtras(extras, extraIndices, scalars, lists)
+	})
+	m.Elements = append(m.Elements, Element{Name: e.name, Count: e.count, Properties: extras})
+	return err
+}
+
+// column produces the values of a single property for every instance of an
+// element being written
+type column struct {
+	property Property
+	scalar   func(i int) float64
+	list     func(i int) []float64
+}
+
+func dataColumns(data []PropertyData) []column {
+	columns := make([]column, len(data))
+	for i, pd := range data {
+		columns[i] = column{
+			property: pd.Property,
+			scalar:   func(j int) float64 { return pd.Values[j] },
+			list:     func(j int) []float64 { return pd.Lists[j] },
+		}
+	}
+	return columns
+}
+
+func validateColumns(element string, count int, data []PropertyData) error {
+	for _, pd := range data {
+		if err := pd.validate(); err != nil {
+			return err
+		}
+		if pd.len() != count {
+			return fmt.Errorf("ply %s property %s has %d values, expected %d", element, pd.Name, pd.len(), count)
+		}
+	}
+	return nil
+}
+
+func (m Mesh) vertexColumns() ([]column, error) {
+	count := len(m.Vertices)
+	if len(m.Normals) != 0 && len(m.Normals) != count {
+		return nil, fmt.Errorf("ply mesh has %d normals, expected %d", len(m.Normals), count)
+	}
+	if len(m.Colors) != 0 && len(m.Colors) != count {
+		return nil, fmt.Errorf("ply mesh has %d colors, expected %d", len(m.Colors), count)
+	}
+	if err := validateColumns("vertex", count, m.VertexProperties); err != nil {
+		return nil, err
+	}
+
+	precision := "float"
+	if m.DoublePrecision {
+		precision = "double"
+	}
+
+	columns := []column{
+		{property: Property{Name: "x", Type: precision}, scalar: func(i int) float64 { return m.Vertices[i].X() }},
+		{property: Property{Name: "y", Type: precision}, scalar: func(i int) float64 { return m.Vertices[i].Y() }},
+		{property: Property{Name: "z", Type: precision}, scalar: func(i int) float64 { return m.Vertices[i].Z() }},
+	}
+
+	if len(m.Normals) > 0 {
+		columns = append(columns,
+			column{property: Property{Name: "nx", Type: precision}, scalar: func(i int) float64 { return m.Normals[i].X() }},
+			column{property: Property{Name: "ny", Type: precision}, scalar: func(i int) float64 { return m.Normals[i].Y() }},
+			column{property: Property{Name: "nz", Type: precision}, scalar: func(i int) float64 { return m.Normals[i].Z() }},
+		)
+	}
+
+	if len(m.Colors) > 0 {
+		colorType := m.ColorType
+		if colorType == "" {
+			colorType = "uchar"
+		}
+		t, err := parseType(colorType)
+		if err != nil {
+			return nil, fmt.Errorf("ply color type: %w", err)
+		}
+
+		columns = append(columns,
+			column{property: Property{Name: "red", Type: colorType}, scalar: func(i int) float64 { return colorValue(t, m.Colors[i].X()) }},
+			column{property: Property{Name: "green", Type: colorType}, scalar: func(i int) float64 { return colorValue(t, m.Colors[i].Y()) }},
+			column{property: Property{Name: "blue", Type: colorType}, scalar: func(i int) float64 { return colorValue(t, m.Colors[i].Z()) }},
+		)
+		if m.Alpha {
+			columns = append(columns, column{property: Property{Name: "alpha", Type: colorType}, scalar: func(i int) float64 { return colorValue(t, m.Colors[i].W()) }})
+		}
+	}
+
+	for _, pd := range m.VertexProperties {
+		for _, c := range columns {
+			if c.property.Name == pd.Name {
+				return nil, fmt.Errorf("ply vertex property %s conflicts with the mesh's own data", pd.Name)
+			}
+		}
+	}
+	return append(columns, dataColumns(m.VertexProperties)...), nil
+}
+
+func (m Mesh) faceColumns() ([]column, error) {
+	if err := validateColumns("face", len(m.Faces), m.FaceProperties); err != nil {
+		return nil, err
+	}
+
+	countType := "uchar"
+	for i, face := range m.Faces {
+		if len(face) > math.MaxUint8 {
+			countType = "int"
+		}
+		for _, index := range face {
+			if index < 0 || index >= len(m.Vertices) {
+				return nil, fmt.Errorf("face %d references vertex %d, but there are only %d vertices", i, index, len(m.Vertices))
+			}
+		}
+	}
+
+	var scratch []float64
+	columns := []column{{
+		property: Property{Name: "vertex_indices", Type: "int", CountType: countType},
+		list: func(i int) []float64 {
+			scratch = scratch[:0]
+			for _, index := range m.Faces[i] {
+				scratch = append(scratch, float64(index))
+			}
+			return scratch
+		},
+	}}
+
+	for _, pd := range m.FaceProperties {
+		if pd.Name == "vertex_indices" || pd.Name == "vertex_index" {
+			return nil, fmt.Errorf("ply face property %s conflicts with the mesh's own data", pd.Name)
+		}
+	}
+	return append(columns, dataColumns(m.FaceProperties)...), nil
+}
+
+func writeInstances(out valueWriter, name string, count int, columns []column) error {
+	types := make([]scalarType, len(columns))
+	countTypes := make([]scalarType, len(columns))
+	for i, c := range columns {
+		types[i], _ = parseType(c.property.Type)
+		countTypes[i], _ = parseType(c.property.CountType)
+	}
+
+	for i := 0; i < count; i++ {
+		for j, c := range columns {
+			if !c.property.IsList() {
+				if err := out.write(types[j], c.scalar(i)); err != nil {
+					return fmt.Errorf("ply element %s %d property %s: %w", name, i, c.property.Name, err)
+				}
+				continue
+			}
+
+			list := c.list(i)
+			if err := out.write(countTypes[j], float64(len(list))); err != nil {
+				return fmt.Errorf("ply element %s %d property %s: %w", name, i, c.property.Name, err)
+			}
+			for _, v := range list {
+				if err := out.write(types[j], v); err != nil {
+					return fmt.Errorf("ply element %s %d property %s: %w", name, i, c.property.Name, err)
+				}
+			}
+		}
+		if err := out.end(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write writes the mesh as a PLY file in the format provided. Positions and
+// normals are written as floats, or doubles if DoublePrecision is set, while
+// colors are written as ColorType. A face element is only written when the
+// mesh has faces or face properties, and elements are written in the order
+// given by ElementOrder.
+func (m Mesh) Write(out io.Writer, format Format) error {
+	for _, line := range append(append([]string{}, m.Comments...), m.ObjInfo...) {
+		if strings.ContainsAny(line, "\r\n") {
+			return fmt.Errorf("ply header line can not contain a line break: %q", line)
+		}
+	}
+
+	type elementColumns struct {
+		name    string
+		count   int
+		columns []column
+	}
+
+	vertexColumns, err := m.vertexColumns()
+	if err != nil {
+		return err
+	}
+	elements := []elementColumns{{name: "vertex", count: len(m.Vertices), columns: vertexColumns}}
+
+	if len(m.Faces) > 0 || len(m.FaceProperties) > 0 {
+		faceColumns, err := m.faceColumns()
+		if err != nil {
+			return err
+		}
+		elements = append(elements, elementColumns{name: "face", count: len(m.Faces), columns: faceColumns})
+	}
+
+	for _, e := range m.Elements {
+		if e.Name == "" || strings.ContainsAny(e.Name, " \t\r\n") || e.Name == "vertex" || e.Name == "face" {
+			return fmt.Errorf("invalid ply element name: %q", e.Name)
+		}
+		if err := validateColumns(e.Name, e.Count, e.Properties); err != nil {
+			return err
+		}
+		elements = append(elements, elementColumns{name: e.Name, count: e.Count, columns: dataColumns(e.Properties)})
+	}
+
+	// Stable sort the elements named by ElementOrder ahead of the rest
+	rank := make(map[string]int, len(m.ElementOrder))
+	for i, name := range m.ElementOrder {
+		if _, ok := rank[name]; ok {
+			return fmt.Errorf("ply element order names %s more than once", name)
+		}
+		rank[name] = i
+	}
+	for _, name := range m.ElementOrder {
+		if !slices.ContainsFunc(elements, func(e elementColumns) bool { return e.name == name }) {
+			return fmt.Errorf("ply element order names %s, which the mesh doesn't have", name)
+		}
+	}
+	slices.SortStableFunc(elements, func(a, b elementColumns) int {
+		ra, okA := rank[a.name]
+		rb, okB := rank[b.name]
+		switch {
+		case okA && okB:
+			return ra - rb
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+
+	h := header{format: format, comments: m.Comments, objInfo: m.ObjInfo}
+	for _, e := range elements {
+		properties := make([]Property, len(e.columns))
+		for i, c := range e.columns {
+			properties[i] = c.property
+		}
+		h.elements = append(h.elements, elementHeader{name: e.name, count: e.count, properties: properties})
+	}
+
+	bw := bufio.NewWriter(out)
+	var values valueWriter
+	switch format {
+	case ASCII:
+		values = &asciiWriter{out: bw}
+	case BinaryLittleEndian:
+		values = &binaryWriter{out: bw, endian: binary.LittleEndian}
+	case BinaryBigEndian:
+		values = &binaryWriter{out: bw, endian: binary.BigEndian}
+	default:
+		panic(fmt.Errorf("unknown ply format: %d", format))
+	}
+
+	if err := writeHeader(bw, h); err != nil {
+		return err
+	}
+	for _, e := range elements {
+		if err := writeInstances(values, e.name, e.count, e.columns); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
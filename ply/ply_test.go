@@ -0,0 +1,481 @@
+package ply_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image/color"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/ply"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+const asciiCube = `ply
+format ascii 1.0
+comment made by hand
+obj_info a tiny example
+element vertex 4
+property float x
+property float y
+property float z
+property float nx
+property float ny
+property float nz
+property uchar red
+property uchar green
+property uchar blue
+property float intensity
+element face 2
+property list uchar int vertex_indices
+property uchar material
+element edge 1
+property int vertex1
+property int vertex2
+end_header
+0 0 0 0 0 1 255 0 0 0.5
+1 0 0 0 0 1 0 255 0 0.25
+1 1 0 0 0 1 0 0 255 1
+0 1 0 0 0 1 255 255 255 0
+3 0 1 2 7
+3 0 2 3 8
+0 2
+`
+
+func TestRead_ASCII(t *testing.T) {
+	m, err := ply.Read(strings.NewReader(asciiCube))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"made by hand"}, m.Comments)
+	assert.Equal(t, []string{"a tiny example"}, m.ObjInfo)
+	assert.False(t, m.DoublePrecision)
+
+	assert.Equal(t, vector3.Float64Array{
+		vector3.New(0., 0., 0.),
+		vector3.New(1., 0., 0.),
+		vector3.New(1., 1., 0.),
+		vector3.New(0., 1., 0.),
+	}, m.Vertices)
+
+	assert.Len(t, m.Normals, 4)
+	assert.Equal(t, vector3.Forward[float64](), m.Normals[2])
+
+	assert.Equal(t, vector4.Float64Array{
+		vector4.FromColor(color.RGBA{R: 255, A: 255}),
+		vector4.FromColor(color.RGBA{G: 255, A: 255}),
+		vector4.FromColor(color.RGBA{B: 255, A: 255}),
+		vector4.FromColor(color.White),
+	}, m.Colors)
+	assert.Equal(t, vector3.FromColor(color.RGBA{R: 255, A: 255}), m.Colors[0].XYZ())
+
+	assert.Equal(t, [][]int{{0, 1, 2}, {0, 2, 3}}, m.Faces)
+
+	assert.Equal(t, []ply.PropertyData{{
+		Property: ply.Property{Name: "intensity", Type: "float"},
+		Values:   []float64{0.5, 0.25, 1, 0},
+	}}, m.VertexProperties)
+
+	assert.Equal(t, []ply.PropertyData{{
+		Property: ply.Property{Name: "material", Type: "uchar"},
+		Values:   []float64{7, 8},
+	}}, m.FaceProperties)
+
+	assert.Equal(t, []ply.Element{{
+		Name:  "edge",
+		Count: 1,
+		Properties: []ply.PropertyData{
+			{Property: ply.Property{Name: "vertex1", Type: "int"}, Values: []float64{0}},
+			{Property: ply.Property{Name: "vertex2", Type: "int"}, Values: []float64{2}},
+		},
+	}}, m.Elements)
+}
+
+func TestWrite_ASCII(t *testing.T) {
+	m, err := ply.Read(strings.NewReader(asciiCube))
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf, ply.ASCII))
+	assert.Equal(t, asciiCube, buf.String())
+}
+
+func TestReadWrite_RoundTrip(t *testing.T) {
+	original := ply.Mesh{
+		Comments: []string{"round trip"},
+		Vertices: vector3.Float64Array{
+			vector3.New(1.5, -2.25, 3.),
+			vector3.New(0.1, 0.2, 0.3),
+			vector3.New(-1e10, 1e-10, 0),
+		},
+		Normals: vector3.Float64Array{
+			vector3.New(0., 1., 0.),
+			vector3.New(1., 0., 0.),
+			vector3.New(0., 0., -1.),
+		},
+		Colors: vector4.Float64Array{
+			vector4.New(1., 0., 0., 1.),
+			vector4.New(0., 128./255., 0., 64./255.),
+			vector4.New(0., 0., 1., 0.),
+		},
+		Alpha:           true,
+		ColorType:       "ushort",
+		Faces:           [][]int{{0, 1, 2}, {2, 1, 0}},
+		DoublePrecision: true,
+		VertexProperties: []ply.PropertyData{
+			{Property: ply.Property{Name: "confidence", Type: "ushort"}, Values: []float64{0, 65535, 12}},
+			{Property: ply.Property{Name: "tags", Type: "char", CountType: "uchar"}, Lists: [][]float64{{-1, 2}, {}, {127}}},
+		},
+		FaceProperties: []ply.PropertyData{
+			{Property: ply.Property{Name: "area", Type: "double"}, Values: []float64{math.Pi, -math.E}},
+		},
+		Elements: []ply.Element{
+			{Name: "camera", Count: 1, Properties: []ply.PropertyData{
+				{Property: ply.Property{Name: "view_px", Type: "float"}, Values: []float64{0.5}},
+				{Property: ply.Property{Name: "id", Type: "uint"}, Values: []float64{math.MaxUint32}},
+			}},
+			{Name: "empty", Count: 2},
+		},
+		ElementOrder: []string{"camera", "vertex", "empty", "face"},
+	}
+
+	for _, format := range []ply.Format{ply.ASCII, ply.BinaryLittleEndian, ply.BinaryBigEndian} {
+		t.Run(format.String(), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			assert.NoError(t, original.Write(buf, format))
+			assert.True(t, strings.HasPrefix(buf.String(), "ply\nformat "+format.String()+" 1.0\n"))
+
+			back, err := ply.Read(buf)
+			assert.NoError(t, err)
+			assert.Equal(t, original, back)
+		})
+	}
+}
+
+func TestWrite_Float(t *testing.T) {
+	m := ply.Mesh{Vertices: vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(0.1, 0.2, 0.3)}}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf, ply.BinaryLittleEndian))
+
+	header := "ply\nformat binary_little_endian 1.0\nelement vertex 2\nproperty float x\nproperty float y\nproperty float z\nend_header\n"
+	assert.Equal(t, header, buf.String()[:len(header)])
+	body := buf.Bytes()[len(header):]
+	assert.Len(t, body, 2*3*4)
+	assert.Equal(t, float32(0.2), math.Float32frombits(binary.LittleEndian.Uint32(body[16:])))
+
+	back, err := ply.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.New(1., 2., 3.), back.Vertices[0])
+	assert.InDelta(t, 0.2, back.Vertices[1].Y(), 1e-7)
+	assert.Empty(t, back.Normals)
+	assert.Empty(t, back.Colors)
+	assert.Empty(t, back.Faces)
+}
+
+func TestRead_Binary(t *testing.T) {
+	header := "ply\r\nformat binary_big_endian 1.0\r\nelement vertex 1\r\nproperty double x\r\nproperty double y\r\nproperty double z\r\nproperty ushort red\r\nproperty ushort green\r\nproperty ushort blue\r\nproperty uint8 alpha\r\nend_header\r\n"
+	body := make([]byte, 3*8+3*2+1)
+	binary.BigEndian.PutUint64(body, math.Float64bits(1))
+	binary.BigEndian.PutUint64(body[8:], math.Float64bits(-2))
+	binary.BigEndian.PutUint64(body[16:], math.Float64bits(0.125))
+	binary.BigEndian.PutUint16(body[24:], 65535)
+	binary.BigEndian.PutUint16(body[26:], 0)
+	binary.BigEndian.PutUint16(body[28:], 32768)
+	body[30] = 51
+
+	m, err := ply.Read(bytes.NewReader(append([]byte(header), body...)))
+	assert.NoError(t, err)
+	assert.True(t, m.DoublePrecision)
+	assert.Equal(t, vector3.Float64Array{vector3.New(1., -2., 0.125)}, m.Vertices)
+	assert.Equal(t, vector4.Float64Array{vector4.New(1., 0., 32768./65535., 0.2)}, m.Colors)
+	assert.True(t, m.Alpha)
+}
+
+func TestWrite_OpaqueAlpha(t *testing.T) {
+	data := "ply\nformat ascii 1.0\nelement vertex 1\n" +
+		"property float x\nproperty float y\nproperty float z\n" +
+		"property uchar red\nproperty uchar green\nproperty uchar blue\nproperty uchar alpha\n" +
+		"end_header\n0 0 0 255 0 0 255\n"
+
+	m, err := ply.Read(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.True(t, m.Alpha)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf, ply.ASCII))
+	assert.Equal(t, data, buf.String())
+
+	m.Alpha = false
+	buf.Reset()
+	assert.NoError(t, m.Write(buf, ply.ASCII))
+	assert.NotContains(t, buf.String(), "alpha")
+}
+
+func TestRead_ColorTypes(t *testing.T) {
+	tests := map[string]struct {
+		colorType string
+		values    string
+		want      vector4.Float64
+	}{
+		"uchar": {
+			colorType: "uchar",
+			values:    "255 0 51",
+			want:      vector4.FromColor(color.RGBA{R: 255, B: 51, A: 255}),
+		},
+		"ushort": {
+			colorType: "ushort",
+			values:    "65535 0 32768",
+			want:      vector4.FromColor(color.RGBA64{R: 65535, B: 32768, A: 65535}),
+		},
+		"char": {
+			colorType: "char",
+			values:    "127 -128 0",
+			want:      vector4.New(1., 0., 0., 1.),
+		},
+		"float": {
+			colorType: "float",
+			values:    "1 0 0.5",
+			want:      vector4.FromColor(color.RGBA64{R: 65535, B: 32768, A: 65535}),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := "ply\nformat ascii 1.0\nelement vertex 1\n" +
+				"property float x\nproperty float y\nproperty float z\n" +
+				"property " + tc.colorType + " red\n" +
+				"property " + tc.colorType + " green\n" +
+				"property " + tc.colorType + " blue\n" +
+				"end_header\n0 0 0 " + tc.values + "\n"
+
+			m, err := ply.Read(strings.NewReader(data))
+			assert.NoError(t, err)
+			assert.Equal(t, vector4.Float64Array{tc.want}, m.Colors)
+		})
+	}
+}
+
+func TestWrite_KeepsLayout(t *testing.T) {
+	data := `ply
+format ascii 1.0
+element face 1
+property list uchar int vertex_indices
+element camera 1
+property float view_px
+element vertex 3
+property float x
+property float y
+property float z
+property ushort red
+property ushort green
+property ushort blue
+end_header
+3 0 1 2
+0.5
+0 0 0 65535 0 12
+1 0 0 0 65535 0
+0 1 0 0 0 65535
+`
+
+	m, err := ply.Read(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"face", "camera", "vertex"}, m.ElementOrder)
+	assert.Equal(t, "ushort", m.ColorType)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf, ply.ASCII))
+	assert.Equal(t, data, buf.String())
+}
+
+func TestWrite_ElementOrder(t *testing.T) {
+	m := ply.Mesh{
+		Vertices: vector3.Float64Array{vector3.New(0., 0., 0.)},
+		Faces:    [][]int{{0, 0, 0}},
+		Elements: []ply.Element{{Name: "a", Count: 0}, {Name: "b", Count: 0}},
+	}
+
+	tests := map[string]struct {
+		order []string
+		want  string
+		err   string
+	}{
+		"default":  {want: "vertex face a b"},
+		"partial":  {order: []string{"b", "face"}, want: "b face vertex a"},
+		"complete": {order: []string{"a", "face", "b", "vertex"}, want: "a face b vertex"},
+		"unknown": {
+			order: []string{"vertex", "c"},
+			err:   "ply element order names c, which the mesh doesn't have",
+		},
+		"repeated": {
+			order: []string{"a", "vertex", "a"},
+			err:   "ply element order names a more than once",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m.ElementOrder = tc.order
+			buf := &bytes.Buffer{}
+			err := m.Write(buf, ply.ASCII)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			back, err := ply.Read(buf)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, strings.Join(back.ElementOrder, " "))
+		})
+	}
+}
+
+func TestRead_PartialGroupsPassThrough(t *testing.T) {
+	data := `ply
+format ascii 1.0
+element vertex 1
+property float x
+property float y
+property float z
+property float nx
+property float alpha
+end_header
+1 2 3 4 5
+`
+	m, err := ply.Read(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Empty(t, m.Normals)
+	assert.Empty(t, m.Colors)
+	assert.Equal(t, []ply.PropertyData{
+		{Property: ply.Property{Name: "nx", Type: "float"}, Values: []float64{4}},
+		{Property: ply.Property{Name: "alpha", Type: "float"}, Values: []float64{5}},
+	}, m.VertexProperties)
+}
+
+func TestRead_Errors(t *testing.T) {
+	vertexHeader := "ply\nformat ascii 1.0\nelement vertex 2\nproperty float x\nproperty float y\nproperty float z\n"
+	tests := map[string]struct {
+		data string
+		err  string
+	}{
+		"not ply":            {data: "obj\n", err: `not a ply file, started with "obj"`},
+		"empty":              {data: "", err: "unexpected EOF"},
+		"unknown format":     {data: "ply\nformat binary 1.0\nend_header\n", err: "unknown ply format: binary"},
+		"unknown version":    {data: "ply\nformat ascii 2.0\nend_header\n", err: "unsupported ply version: 2.0"},
+		"missing format":     {data: "ply\nend_header\n", err: "ply header is missing a format"},
+		"unknown type":       {data: "ply\nformat ascii 1.0\nelement vertex 0\nproperty half x\nend_header\n", err: "unknown property type: half"},
+		"float list count":   {data: "ply\nformat ascii 1.0\nelement face 0\nproperty list float int vertex_indices\nend_header\n", err: "ply list property vertex_indices has non integer count type float"},
+		"orphan property":    {data: "ply\nformat ascii 1.0\nproperty float x\nend_header\n", err: `ply property declared before any element: "property float x"`},
+		"duplicate":          {data: "ply\nformat ascii 1.0\nelement vertex 0\nproperty float x\nproperty float x\nend_header\n", err: "ply element vertex declares property x twice"},
+		"bad count":          {data: "ply\nformat ascii 1.0\nelement vertex -1\nend_header\n", err: `invalid ply element count: "-1"`},
+		"unknown line":       {data: "ply\nformat ascii 1.0\nsomething\nend_header\n", err: `unrecognized ply header line: "something"`},
+		"missing header end": {data: "ply\nformat ascii 1.0\n", err: "unexpected EOF"},
+		"missing position":   {data: "ply\nformat ascii 1.0\nelement vertex 0\nproperty float x\nend_header\n", err: "ply vertex element is missing scalar x, y, and z properties"},
+		"missing indices":    {data: "ply\nformat ascii 1.0\nelement face 0\nproperty int a\nend_header\n", err: "ply face element is missing a vertex_indices list property"},
+		"truncated":          {data: vertexHeader + "end_header\n1 2 3\n4 5", err: "ply element vertex 1 property z: unexpected EOF"},
+		"bad value":          {data: vertexHeader + "end_header\n1 2 3\n4 five 6", err: `ply element vertex 1 property y: invalid float value: "five"`},
+		"bad integer":        {data: "ply\nformat ascii 1.0\nelement thing 1\nproperty uchar a\nend_header\n256\n", err: `ply element thing 0 property a: invalid uchar value: "256"`},
+		"negative list":      {data: "ply\nformat ascii 1.0\nelement thing 1\nproperty list char int a\nend_header\n-1\n", err: "ply element thing 0 property a: negative list length -1"},
+		"face out of range":  {data: vertexHeader + "element face 1\nproperty list uchar int vertex_indices\nend_header\n1 2 3\n4 5 6\n3 0 1 2\n", err: "face 0 references vertex 2, but there are only 2 vertices"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ply.Read(strings.NewReader(tc.data))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestRead_TruncatedBinary(t *testing.T) {
+	buf := &bytes.Buffer{}
+	m := ply.Mesh{Vertices: make(vector3.Float64Array, 10)}
+	assert.NoError(t, m.Write(buf, ply.BinaryBigEndian))
+
+	_, err := ply.Read(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestWrite_Errors(t *testing.T) {
+	vertices := vector3.Float64Array{vector3.Zero[float64](), vector3.One[float64]()}
+	tests := map[string]struct {
+		mesh ply.Mesh
+		err  string
+	}{
+		"normals": {
+			mesh: ply.Mesh{Vertices: vertices, Normals: vertices[:1]},
+			err:  "ply mesh has 1 normals, expected 2",
+		},
+		"colors": {
+			mesh: ply.Mesh{Vertices: vertices, Colors: vector4.Float64Array{vector4.One[float64]()}},
+			err:  "ply mesh has 1 colors, expected 2",
+		},
+		"color type": {
+			mesh: ply.Mesh{Vertices: vertices[:1], Colors: vector4.Float64Array{vector4.One[float64]()}, ColorType: "half"},
+			err:  "ply color type: unknown property type: half",
+		},
+		"face out of range": {
+			mesh: ply.Mesh{Vertices: vertices, Faces: [][]int{{0, 1, 2}}},
+			err:  "face 0 references vertex 2, but there are only 2 vertices",
+		},
+		"property length": {
+			mesh: ply.Mesh{Vertices: vertices, VertexProperties: []ply.PropertyData{{Property: ply.Property{Name: "a", Type: "int"}, Values: []float64{1}}}},
+			err:  "ply vertex property a has 1 values, expected 2",
+		},
+		"property conflicts": {
+			mesh: ply.Mesh{Vertices: vertices, VertexProperties: []ply.PropertyData{{Property: ply.Property{Name: "x", Type: "int"}, Values: []float64{1, 2}}}},
+			err:  "ply vertex property x conflicts with the mesh's own data",
+		},
+		"property type": {
+			mesh: ply.Mesh{Vertices: vertices, VertexProperties: []ply.PropertyData{{Property: ply.Property{Name: "a", Type: "half"}, Values: []float64{1, 2}}}},
+			err:  "unknown property type: half",
+		},
+		"property name": {
+			mesh: ply.Mesh{Elements: []ply.Element{{Name: "thing", Count: 1, Properties: []ply.PropertyData{{Property: ply.Property{Name: "a b", Type: "int"}, Values: []float64{1}}}}}},
+			err:  `invalid ply property name: "a b"`,
+		},
+		"out of range": {
+			mesh: ply.Mesh{Vertices: vertices, VertexProperties: []ply.PropertyData{{Property: ply.Property{Name: "a", Type: "uchar"}, Values: []float64{1, 300}}}},
+			err:  "ply element vertex 1 property a: value 300 out of range for uchar",
+		},
+		"element name": {
+			mesh: ply.Mesh{Elements: []ply.Element{{Name: "face"}}},
+			err:  `invalid ply element name: "face"`,
+		},
+		"comment": {
+			mesh: ply.Mesh{Comments: []string{"two\nlines"}},
+			err:  `ply header line can not contain a line break: "two\nlines"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, tc.mesh.Write(&bytes.Buffer{}, ply.ASCII), tc.err)
+		})
+	}
+}
+
+func TestWrite_LargeFaces(t *testing.T) {
+	m := ply.Mesh{Vertices: make(vector3.Float64Array, 300), Faces: [][]int{make([]int, 300)}}
+	for i := range m.Faces[0] {
+		m.Faces[0][i] = i
+	}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, m.Write(buf, ply.BinaryLittleEndian))
+	assert.Contains(t, buf.String(), "property list int int vertex_indices\n")
+
+	back, err := ply.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Faces, back.Faces)
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	assert.PanicsWithError(t, "unknown ply format: 7", func() {
+		ply.Mesh{}.Write(&bytes.Buffer{}, ply.Format(7))
+	})
+}
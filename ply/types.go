@@ -0,0 +1,289 @@
+package ply
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// scalarType is one of the numeric types a PLY property may be stored as
+type scalarType int
+
+const (
+	typeChar scalarType = iota + 1
+	typeUchar
+	typeShort
+	typeUshort
+	typeInt
+	typeUint
+	typeFloat
+	typeDouble
+)
+
+// scalarTypes maps every name a type may be declared with, including the
+// sized aliases written by some tools, to the type itself
+var scalarTypes = map[string]scalarType{
+	"char":    typeChar,
+	"int8":    typeChar,
+	"uchar":   typeUchar,
+	"uint8":   typeUchar,
+	"short":   typeShort,
+	"int16":   typeShort,
+	"ushort":  typeUshort,
+	"uint16":  typeUshort,
+	"int":     typeInt,
+	"int32":   typeInt,
+	"uint":    typeUint,
+	"uint32":  typeUint,
+	"float":   typeFloat,
+	"float32": typeFloat,
+	"double":  typeDouble,
+	"float64": typeDouble,
+}
+
+func parseType(name string) (scalarType, error) {
+	if t, ok := scalarTypes[name]; ok {
+		return t, nil
+	}
+	return 0, fmt.Errorf("unknown property type: %s", name)
+}
+
+func (t scalarType) String() string {
+	switch t {
+	case typeChar:
+		return "char"
+	case typeUchar:
+		return "uchar"
+	case typeShort:
+		return "short"
+	case typeUshort:
+		return "ushort"
+	case typeInt:
+		return "int"
+	case typeUint:
+		return "uint"
+	case typeFloat:
+		return "float"
+	case typeDouble:
+		return "double"
+	}
+	return fmt.Sprintf("scalarType(%d)", int(t))
+}
+
+func (t scalarType) size() int {
+	switch t {
+	case typeChar, typeUchar:
+		return 1
+	case typeShort, typeUshort:
+		return 2
+	case typeInt, typeUint, typeFloat:
+		return 4
+	}
+	return 8
+}
+
+func (t scalarType) isInteger() bool {
+	return t != typeFloat && t != typeDouble
+}
+
+// maxValue returns the largest value an integer type can hold
+func (t scalarType) maxValue() float64 {
+	switch t {
+	case typeChar:
+		return math.MaxInt8
+	case typeUchar:
+		return math.MaxUint8
+	case typeShort:
+		return math.MaxInt16
+	case typeUshort:
+		return math.MaxUint16
+	case typeInt:
+		return math.MaxInt32
+	case typeUint:
+		return math.MaxUint32
+	}
+	return math.Inf(1)
+}
+
+// inRange determines whether or not the value can be stored as the type
+// without losing information
+func (t scalarType) inRange(v float64) bool {
+	switch t {
+	case typeChar:
+		return v == math.Trunc(v) && v >= math.MinInt8 && v <= math.MaxInt8
+	case typeShort:
+		return v == math.Trunc(v) && v >= math.MinInt16 && v <= math.MaxInt16
+	case typeInt:
+		return v == math.Trunc(v) && v >= math.MinInt32 && v <= math.MaxInt32
+	case typeUchar, typeUshort, typeUint:
+		return v == math.Trunc(v) && v >= 0 && v <= t.maxValue()
+	}
+	return true
+}
+
+func (t scalarType) put(dst []byte, endian binary.ByteOrder, v float64) {
+	switch t {
+	case typeChar:
+		dst[0] = byte(int8(v))
+	case typeUchar:
+		dst[0] = uint8(v)
+	case typeShort:
+		endian.PutUint16(dst, uint16(int16(v)))
+	case typeUshort:
+		endian.PutUint16(dst, uint16(v))
+	case typeInt:
+		endian.PutUint32(dst, uint32(int32(v)))
+	case typeUint:
+		endian.PutUint32(dst, uint32(v))
+	case typeFloat:
+		endian.PutUint32(dst, math.Float32bits(float32(v)))
+	case typeDouble:
+		endian.PutUint64(dst, math.Float64bits(v))
+	}
+}
+
+func (t scalarType) get(src []byte, endian binary.ByteOrder) float64 {
+	switch t {
+	case typeChar:
+		return float64(int8(src[0]))
+	case typeUchar:
+		return float64(src[0])
+	case typeShort:
+		return float64(int16(endian.Uint16(src)))
+	case typeUshort:
+		return float64(endian.Uint16(src))
+	case typeInt:
+		return float64(int32(endian.Uint32(src)))
+	case typeUint:
+		return float64(endian.Uint32(src))
+	case typeFloat:
+		return float64(math.Float32frombits(endian.Uint32(src)))
+	}
+	return math.Float64frombits(endian.Uint64(src))
+}
+
+// valueReader reads the individual values making up the body of a PLY file
+type valueReader interface {
+	read(t scalarType) (float64, error)
+}
+
+type asciiReader struct {
+	in    *bufio.Reader
+	token []byte
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+func (r *asciiReader) read(t scalarType) (float64, error) {
+	r.token = r.token[:0]
+	for {
+		b, err := r.in.ReadByte()
+		if err == io.EOF && len(r.token) > 0 {
+			break
+		}
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if isSpace(b) {
+			if len(r.token) == 0 {
+				continue
+			}
+			break
+		}
+		r.token = append(r.token, b)
+	}
+
+	v, err := strconv.ParseFloat(string(r.token), 64)
+	if err != nil || (t.isInteger() && !t.inRange(v)) {
+		return 0, fmt.Errorf("invalid %s value: %q", t, r.token)
+	}
+	return v, nil
+}
+
+type binaryReader struct {
+	in     *bufio.Reader
+	endian binary.ByteOrder
+	buf    [8]byte
+}
+
+func (r *binaryReader) read(t scalarType) (float64, error) {
+	data := r.buf[:t.size()]
+	if _, err := io.ReadFull(r.in, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	return t.get(data, r.endian), nil
+}
+
+// valueWriter writes the individual values making up the body of a PLY file
+type valueWriter interface {
+	write(t scalarType, v float64) error
+
+	// end is called once every property of an element has been written
+	end() error
+}
+
+type asciiWriter struct {
+	out     *bufio.Writer
+	started bool
+	buf     []byte
+}
+
+func (w *asciiWriter) write(t scalarType, v float64) error {
+	if !t.inRange(v) {
+		return fmt.Errorf("value %g out of range for %s", v, t)
+	}
+
+	w.buf = w.buf[:0]
+	if w.started {
+		w.buf = append(w.buf, ' ')
+	}
+	w.started = true
+
+	switch t {
+	case typeFloat:
+		w.buf = strconv.AppendFloat(w.buf, v, 'g', -1, 32)
+	case typeDouble:
+		w.buf = strconv.AppendFloat(w.buf, v, 'g', -1, 64)
+	default:
+		w.buf = strconv.AppendInt(w.buf, int64(v), 10)
+	}
+	_, err := w.out.Write(w.buf)
+	return err
+}
+
+func (w *asciiWriter) end() error {
+	w.started = false
+	return w.out.WriteByte('\n')
+}
+
+type binaryWriter struct {
+	out    *bufio.Writer
+	endian binary.ByteOrder
+	buf    [8]byte
+}
+
+func (w *binaryWriter) write(t scalarType, v float64) error {
+	if !t.inRange(v) {
+		return fmt.Errorf("value %g out of range for %s", v, t)
+	}
+	data := w.buf[:t.size()]
+	t.put(data, w.endian, v)
+	_, err := w.out.Write(data)
+	return err
+}
+
+func (w *binaryWriter) end() error {
+	return nil
+}
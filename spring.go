@@ -0,0 +1,177 @@
+package vector
+
+import "math"
+
+// SmoothDamp gradually moves current towards target, simulating a critically
+// damped spring so the value never overshoots. Smooth time is roughly the
+// time it takes to reach the target, and the speed is capped by maxSpeed.
+// The updated velocity is returned alongside the new value and should be fed
+// back into the next call.
+//
+// Based on Game Programming Gems 4, chapter 1.10
+func SmoothDamp[T any](space Space[T], current, target, velocity T, smoothTime, maxSpeed, deltaTime float64) (T, T) {
+	smoothTime = math.Max(0.0001, smoothTime)
+	omega := 2. / smoothTime
+
+	x := omega * deltaTime
+	exp := 1. / (1. + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := space.Sub(current, target)
+	originalTarget := target
+
+	// Clamp maximum speed
+	maxChange := maxSpeed * smoothTime
+	if length := space.Length(change); length > maxChange && length > 0 {
+		change = space.Scale(change, maxChange/length)
+	}
+	target = space.Sub(current, change)
+
+	temp := space.Scale(space.Add(velocity, space.Scale(change, omega)), deltaTime)
+	velocity = space.Scale(space.Sub(velocity, space.Scale(temp, omega)), exp)
+	output := space.Add(target, space.Scale(space.Add(change, temp), exp))
+
+	// Prevent overshooting
+	if space.Dot(space.Sub(originalTarget, current), space.Sub(output, originalTarget)) > 0 {
+		var zero T
+		return originalTarget, zero
+	}
+
+	return output, velocity
+}
+
+// Spring is a damped harmonic oscillator that pulls a position towards a
+// target. Each update is solved analytically, so the result is independent of
+// the frame rate it's stepped at.
+type Spring[T any] struct {
+	space        Space[T]
+	frequency    float64
+	dampingRatio float64
+	position     T
+	velocity     T
+}
+
+// NewSpring creates a spring resting at position. Frequency is the number of
+// oscillations per second, and the damping ratio controls how quickly those
+// oscillations decay: below 1 overshoots, 1 is critically damped, and above 1
+// approaches the target slowly without overshooting.
+func NewSpring[T any](space Space[T], frequency, dampingRatio float64, position T) Spring[T] {
+	return Spring[T]{
+		space:        space,
+		frequency:    frequency,
+		dampingRatio: dampingRatio,
+		position:     position,
+	}
+}
+
+// Position returns the current position of the spring
+func (s Spring[T]) Position() T {
+	return s.position
+}
+
+// Velocity returns the current velocity of the spring
+func (s Spring[T]) Velocity() T {
+	return s.velocity
+}
+
+// Frequency returns the number of oscillations per second of the spring
+func (s Spring[T]) Frequency() float64 {
+	return s.frequency
+}
+
+// DampingRatio returns how quickly the spring's oscillations decay
+func (s Spring[T]) DampingRatio() float64 {
+	return s.dampingRatio
+}
+
+// SetPosition returns a spring with the position changed
+func (s Spring[T]) SetPosition(position T) Spring[T] {
+	s.position = position
+	return s
+}
+
+// SetVelocity returns a spring with the velocity changed
+func (s Spring[T]) SetVelocity(velocity T) Spring[T] {
+	s.velocity = velocity
+	return s
+}
+
+// Update returns the spring after it has been pulled towards target for
+// deltaTime seconds
+//
+// https://www.ryanjuckett.com/damped-springs/
+func (s Spring[T]) Update(target T, deltaTime float64) Spring[T] {
+	pp, pv, vp, vv := springCoefficients(2*math.Pi*s.frequency, s.dampingRatio, deltaTime)
+
+	offset := s.space.Sub(s.position, target)
+	velocity := s.velocity
+
+	s.position = s.space.Add(s.space.Add(s.space.Scale(offset, pp), s.space.Scale(velocity, pv)), target)
+	s.velocity = s.space.Add(s.space.Scale(offset, vp), s.space.Scale(velocity, vv))
+	return s
+}
+
+// springCoefficients computes the terms that map a spring's offset from its
+// target and its velocity to the new offset and velocity after deltaTime
+func springCoefficients(angularFrequency, dampingRatio, deltaTime float64) (pp, pv, vp, vv float64) {
+	const epsilon = 0.0001
+
+	if angularFrequency < epsilon {
+		return 1, 0, 0, 1
+	}
+
+	dampingRatio = math.Max(0, dampingRatio)
+
+	if dampingRatio > 1+epsilon {
+		// Over-damped
+		za := -angularFrequency * dampingRatio
+		zb := angularFrequency * math.Sqrt(dampingRatio*dampingRatio-1)
+		z1 := za - zb
+		z2 := za + zb
+
+		e1 := math.Exp(z1 * deltaTime)
+		e2 := math.Exp(z2 * deltaTime)
+
+		invTwoZb := 1. / (2. * zb)
+		e1OverTwoZb := e1 * invTwoZb
+		e2OverTwoZb := e2 * invTwoZb
+		z1e1OverTwoZb := z1 * e1OverTwoZb
+		z2e2OverTwoZb := z2 * e2OverTwoZb
+
+		pp = e1OverTwoZb*z2 - z2e2OverTwoZb + e2
+		pv = -e1OverTwoZb + e2OverTwoZb
+		vp = (z1e1OverTwoZb - z2e2OverTwoZb + e2) * z2
+		vv = -z1e1OverTwoZb + z2e2OverTwoZb
+		return
+	}
+
+	if dampingRatio < 1-epsilon {
+		// Under-damped
+		omegaZeta := angularFrequency * dampingRatio
+		alpha := angularFrequency * math.Sqrt(1-dampingRatio*dampingRatio)
+
+		expTerm := math.Exp(-omegaZeta * deltaTime)
+		cosTerm := math.Cos(alpha * deltaTime)
+		sinTerm := math.Sin(alpha * deltaTime)
+
+		expSin := expTerm * sinTerm
+		expCos := expTerm * cosTerm
+		expOmegaZetaSinOverAlpha := expTerm * omegaZeta * sinTerm / alpha
+
+		pp = expCos + expOmegaZetaSinOverAlpha
+		pv = expSin / alpha
+		vp = -expSin*alpha - omegaZeta*expOmegaZetaSinOverAlpha
+		vv = expCos - expOmegaZetaSinOverAlpha
+		return
+	}
+
+	// Critically damped
+	expTerm := math.Exp(-angularFrequency * deltaTime)
+	timeExp := deltaTime * expTerm
+	timeExpFreq := timeExp * angularFrequency
+
+	pp = timeExpFreq + expTerm
+	pv = timeExp
+	vp = -angularFrequency * timeExpFreq
+	vv = -timeExpFreq + expTerm
+	return
+}
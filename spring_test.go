@@ -0,0 +1,133 @@
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmoothDamp_Converges(t *testing.T) {
+	space := vector3.Space[float64]{}
+	current := vector3.New(1., 2., 3.)
+	target := vector3.New(-4., 5., 10.)
+	velocity := vector3.Zero[float64]()
+
+	for i := 0; i < 600; i++ {
+		current, velocity = vector.SmoothDamp(space, current, target, velocity, 0.3, 1000, 1./60.)
+	}
+
+	assert.InDelta(t, target.X(), current.X(), 0.0001)
+	assert.InDelta(t, target.Y(), current.Y(), 0.0001)
+	assert.InDelta(t, target.Z(), current.Z(), 0.0001)
+	assert.InDelta(t, 0, velocity.Length(), 0.0001)
+}
+
+func TestSmoothDamp_NeverOvershoots(t *testing.T) {
+	space := vector2.Space[float64]{}
+	current := vector2.New(0., 0.)
+	target := vector2.New(10., 0.)
+	velocity := vector2.New(50., 0.)
+
+	for i := 0; i < 300; i++ {
+		current, velocity = vector.SmoothDamp(space, current, target, velocity, 0.1, 1000, 1./30.)
+		assert.LessOrEqual(t, current.X(), target.X())
+	}
+	assert.InDelta(t, target.X(), current.X(), 0.0001)
+}
+
+func TestSmoothDamp_RespectsMaxSpeed(t *testing.T) {
+	space := vector2.Space[float64]{}
+	current := vector2.New(0., 0.)
+	target := vector2.New(1000., 0.)
+	velocity := vector2.Zero[float64]()
+
+	for i := 0; i < 60; i++ {
+		current, velocity = vector.SmoothDamp(space, current, target, velocity, 0.5, 2, 1./60.)
+		assert.LessOrEqual(t, velocity.Length(), 2.+1e-9)
+	}
+	assert.LessOrEqual(t, current.X(), 2.)
+}
+
+func TestSpring_Converges(t *testing.T) {
+	tests := map[string]struct {
+		dampingRatio float64
+	}{
+		"under damped":      {dampingRatio: 0.3},
+		"critically damped": {dampingRatio: 1},
+		"over damped":       {dampingRatio: 2},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := vector3.New(3., -2., 8.)
+			spring := vector.NewSpring(vector3.Space[float64]{}, 2, tc.dampingRatio, vector3.Zero[float64]())
+
+			for i := 0; i < 600; i++ {
+				spring = spring.Update(target, 1./60.)
+			}
+
+			assert.InDelta(t, target.X(), spring.Position().X(), 0.0001)
+			assert.InDelta(t, target.Y(), spring.Position().Y(), 0.0001)
+			assert.InDelta(t, target.Z(), spring.Position().Z(), 0.0001)
+			assert.InDelta(t, 0, spring.Velocity().Length(), 0.0001)
+		})
+	}
+}
+
+func TestSpring_Overshoot(t *testing.T) {
+	tests := map[string]struct {
+		dampingRatio float64
+		overshoots   bool
+	}{
+		"under damped":      {dampingRatio: 0.2, overshoots: true},
+		"critically damped": {dampingRatio: 1, overshoots: false},
+		"over damped":       {dampingRatio: 3, overshoots: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := vector2.New(1., 0.)
+			spring := vector.NewSpring(vector2.Space[float64]{}, 1, tc.dampingRatio, vector2.Zero[float64]())
+
+			maxX := 0.
+			for i := 0; i < 600; i++ {
+				spring = spring.Update(target, 1./60.)
+				maxX = max(maxX, spring.Position().X())
+			}
+
+			assert.Equal(t, tc.overshoots, maxX > target.X()+1e-9)
+		})
+	}
+}
+
+func TestSpring_FrameRateIndependent(t *testing.T) {
+	target := vector3.New(5., 5., -5.)
+	start := vector.NewSpring(vector3.Space[float64]{}, 1.5, 0.4, vector3.Zero[float64]()).
+		SetVelocity(vector3.New(1., 0., 0.))
+
+	single := start.Update(target, 1)
+
+	stepped := start
+	for i := 0; i < 240; i++ {
+		stepped = stepped.Update(target, 1./240.)
+	}
+
+	assert.InDelta(t, single.Position().X(), stepped.Position().X(), 1e-9)
+	assert.InDelta(t, single.Position().Y(), stepped.Position().Y(), 1e-9)
+	assert.InDelta(t, single.Position().Z(), stepped.Position().Z(), 1e-9)
+	assert.InDelta(t, single.Velocity().X(), stepped.Velocity().X(), 1e-9)
+	assert.InDelta(t, single.Velocity().Y(), stepped.Velocity().Y(), 1e-9)
+	assert.InDelta(t, single.Velocity().Z(), stepped.Velocity().Z(), 1e-9)
+}
+
+func TestSpring_ZeroFrequencyDoesNotMove(t *testing.T) {
+	spring := vector.NewSpring(vector2.Space[float64]{}, 0, 1, vector2.New(1., 2.))
+	assert.Equal(t, 0., spring.Frequency())
+	assert.Equal(t, 1., spring.DampingRatio())
+
+	spring = spring.Update(vector2.New(10., 10.), 1)
+	assert.Equal(t, vector2.New(1., 2.), spring.Position())
+}
@@ -0,0 +1,405 @@
+// Package stl reads and writes triangle meshes stored in the STL format used
+// by 3D printing software, in both its ASCII and binary encodings.
+package stl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Format is the encoding of an STL file
+type Format int
+
+const (
+	ASCII Format = iota
+	Binary
+)
+
+const binaryHeaderSize = 80
+
+// Mesh is a list of triangles, with every three consecutive positions making
+// up a single triangle
+type Mesh struct {
+	// Name is the name of an ASCII solid, or the header of a binary file
+	Name string
+
+	Positions vector3.Float32Array
+
+	// Normals holds a normal per triangle, as found in the file read
+	Normals vector3.Float32Array
+
+	// Attributes holds the attribute byte count stored with each triangle
+	// of a binary file, which some software uses for color. It's nil when
+	// every triangle's is zero.
+	Attributes []uint16
+}
+
+// Read reads an ASCII or binary STL file. Binary files whose header begins
+// with "solid" are told apart from ASCII ones by their contents.
+func Read(in io.Reader) (Mesh, error) {
+	br := bufio.NewReader(in)
+	start, err := br.Peek(512)
+	if err != nil && err != io.EOF {
+		return Mesh{}, err
+	}
+
+	if isASCII(start) {
+		return readASCII(br)
+	}
+	return readBinary(br)
+}
+
+// isASCII determines whether or not a file starting with the bytes provided
+// is ASCII. An ASCII file starts with "solid", and either continues with a
+// facet or holds nothing but text.
+func isASCII(start []byte) bool {
+	trimmed := bytes.TrimLeft(start, " \t\r\n")
+	if !bytes.HasPrefix(trimmed, []byte("solid")) {
+		return false
+	}
+
+	if i := bytes.IndexByte(trimmed, '\n'); i >= 0 {
+		if fields := bytes.Fields(trimmed[i:]); len(fields) > 0 {
+			keyword := string(bytes.ToLower(fields[0]))
+			if keyword == "facet" || keyword == "endsolid" {
+				return true
+			}
+		}
+	}
+
+	for _, b := range start {
+		if b < 0x20 && b != '\t' && b != '\r' && b != '\n' {
+			return false
+		}
+	}
+	return true
+}
+
+func readBinary(in io.Reader) (Mesh, error) {
+	header := make([]byte, binaryHeaderSize+4)
+	if _, err := io.ReadFull(in, header); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return Mesh{}, fmt.Errorf("stl header: %w", err)
+	}
+
+	m := Mesh{Name: strings.TrimRight(string(header[:binaryHeaderSize]), "\x00 ")}
+	count := int(binary.LittleEndian.Uint32(header[binaryHeaderSize:]))
+
+	// The count isn't trusted when allocating, as it may not match the
+	// size of the file
+	capacity := min(count, 1<<16)
+	m.Positions = make(vector3.Float32Array, 0, capacity*3)
+	m.Normals = make(vector3.Float32Array, 0, capacity)
+	attributes := make([]uint16, 0, capacity)
+
+	attributeBuf := make([]byte, 2)
+	nonZero := false
+	for i := range count {
+		for j := range 4 {
+			v, err := vector3.ReadFloat32(in, binary.LittleEndian)
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return Mesh{}, fmt.Errorf("stl triangle %d: %w", i, err)
+			}
+			if j == 0 {
+				m.Normals = append(m.Normals, v)
+			} else {
+				m.Positions = append(m.Positions, v)
+			}
+		}
+
+		if _, err := io.ReadFull(in, attributeBuf); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return Mesh{}, fmt.Errorf("stl triangle %d: %w", i, err)
+		}
+		attribute := binary.LittleEndian.Uint16(attributeBuf)
+		nonZero = nonZero || attribute != 0
+		attributes = append(attributes, attribute)
+	}
+
+	if nonZero {
+		m.Attributes = attributes
+	}
+	return m, nil
+}
+
+// asciiReader reads the lines of an ASCII STL file, split into fields
+type asciiReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *asciiReader) errorf(format string, a ...any) error {
+	return fmt.Errorf("stl line %d: %w", r.line, fmt.Errorf(format, a...))
+}
+
+// next returns the fields of the next non blank line, with the keyword
+// starting it lower cased, or io.EOF once there are none
+func (r *asciiReader) next() ([]string, error) {
+	for r.scanner.Scan() {
+		r.line++
+		fields := strings.Fields(r.scanner.Text())
+		if len(fields) > 0 {
+			fields[0] = strings.ToLower(fields[0])
+			return fields, nil
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// expect reads the next line, requiring it to start with the keywords
+// provided, and returns the fields following them
+func (r *asciiReader) expect(keywords ...string) ([]string, error) {
+	fields, err := r.next()
+	if err == io.EOF {
+		return nil, r.errorf("expected %q, found the end of the file", strings.Join(keywords, " "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) < len(keywords) {
+		return nil, r.errorf("expected %q", strings.Join(keywords, " "))
+	}
+	for i, keyword := range keywords {
+		if strings.ToLower(fields[i]) != keyword {
+			return nil, r.errorf("expected %q", strings.Join(keywords, " "))
+		}
+	}
+	return fields[len(keywords):], nil
+}
+
+func (r *asciiReader) vector(fields []string) (vector3.Float32, error) {
+	if len(fields) != 3 {
+		return vector3.Float32{}, r.errorf("expected 3 values, found %d", len(fields))
+	}
+	var values [3]float32
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 32)
+		if err != nil {
+			return vector3.Float32{}, r.errorf("invalid number: %q", f)
+		}
+		values[i] = float32(v)
+	}
+	return vector3.New(values[0], values[1], values[2]), nil
+}
+
+func readASCII(in io.Reader) (Mesh, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	r := asciiReader{scanner: scanner}
+
+	m := Mesh{
+		Positions: vector3.Float32Array{},
+		Normals:   vector3.Float32Array{},
+	}
+
+	// Some files hold more than one solid, which are merged, keeping the
+	// name of the first
+	solids := 0
+	inSolid := false
+	for {
+		fields, err := r.next()
+		if err == io.EOF {
+			if inSolid || solids == 0 {
+				return Mesh{}, r.errorf("expected \"endsolid\", found the end of the file")
+			}
+			return m, nil
+		}
+		if err != nil {
+			return Mesh{}, err
+		}
+
+		switch {
+		case fields[0] == "solid" && !inSolid:
+			if solids == 0 {
+				m.Name = strings.Join(fields[1:], " ")
+			}
+			solids++
+			inSolid = true
+
+		case fields[0] == "endsolid" && inSolid:
+			inSolid = false
+
+		case fields[0] == "facet" && inSolid:
+			if len(fields) < 2 || strings.ToLower(fields[1]) != "normal" {
+				return Mesh{}, r.errorf("expected \"facet normal\"")
+			}
+			normal, err := r.vector(fields[2:])
+			if err != nil {
+				return Mesh{}, err
+			}
+
+			if _, err := r.expect("outer", "loop"); err != nil {
+				return Mesh{}, err
+			}
+			for range 3 {
+				values, err := r.expect("vertex")
+				if err != nil {
+					return Mesh{}, err
+				}
+				v, err := r.vector(values)
+				if err != nil {
+					return Mesh{}, err
+				}
+				m.Positions = append(m.Positions, v)
+			}
+			if _, err := r.expect("endloop"); err != nil {
+				return Mesh{}, err
+			}
+			if _, err := r.expect("endfacet"); err != nil {
+				return Mesh{}, err
+			}
+			m.Normals = append(m.Normals, normal)
+
+		case inSolid:
+			return Mesh{}, r.errorf("expected \"facet\" or \"endsolid\", found %q", fields[0])
+
+		default:
+			return Mesh{}, r.errorf("expected \"solid\", found %q", fields[0])
+		}
+	}
+}
+
+// faceNormal computes the normal of a counter clockwise wound triangle,
+// which is zero for degenerate triangles
+func faceNormal(a, b, c vector3.Float32) vector3.Float32 {
+	a64 := a.ToFloat64()
+	n := b.ToFloat64().Sub(a64).Cross(c.ToFloat64().Sub(a64))
+	length := n.Length()
+	if length == 0 || math.IsNaN(length) || math.IsInf(length, 0) {
+		return vector3.Zero[float32]()
+	}
+	return n.DivByConstant(length).ToFloat32()
+}
+
+// FaceNormals computes the normal of every counter clockwise wound triangle
+// in the list of positions provided
+func FaceNormals(positions vector3.Float32Array) vector3.Float32Array {
+	if len(positions)%3 != 0 {
+		panic(fmt.Errorf("position count %d is not a multiple of 3", len(positions)))
+	}
+	normals := make(vector3.Float32Array, len(positions)/3)
+	for i := range normals {
+		normals[i] = faceNormal(positions[i*3], positions[i*3+1], positions[i*3+2])
+	}
+	return normals
+}
+
+func (m Mesh) validate() error {
+	if len(m.Positions)%3 != 0 {
+		return fmt.Errorf("position count %d is not a multiple of 3", len(m.Positions))
+	}
+	triangles := len(m.Positions) / 3
+	if m.Attributes != nil && len(m.Attributes) != triangles {
+		return fmt.Errorf("mesh has %d triangles, but %d attributes", triangles, len(m.Attributes))
+	}
+	return nil
+}
+
+// Write writes the mesh as an STL file in the format provided. Normals are
+// recomputed from the winding of each triangle rather than taken from the
+// mesh, so that they always agree with its positions. Attributes are only
+// written by the binary format.
+func (m Mesh) Write(out io.Writer, format Format) error {
+	if format != ASCII && format != Binary {
+		panic(fmt.Errorf("unknown stl format: %d", format))
+	}
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(out)
+	normals := FaceNormals(m.Positions)
+	if format == Binary {
+		if err := m.writeBinary(bw, normals); err != nil {
+			return err
+		}
+	} else {
+		if err := m.writeASCII(bw, normals); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func (m Mesh) writeBinary(out *bufio.Writer, normals vector3.Float32Array) error {
+	if len(m.Name) > binaryHeaderSize {
+		return fmt.Errorf("stl name is %d bytes long, binary headers hold at most %d", len(m.Name), binaryHeaderSize)
+	}
+	if uint64(len(normals)) > math.MaxUint32 {
+		return fmt.Errorf("binary stl files can not hold %d triangles", len(normals))
+	}
+
+	header := make([]byte, binaryHeaderSize+4)
+	copy(header, m.Name)
+	binary.LittleEndian.PutUint32(header[binaryHeaderSize:], uint32(len(normals)))
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+
+	attribute := make([]byte, 2)
+	for i, normal := range normals {
+		if err := normal.Write(out, binary.LittleEndian); err != nil {
+			return err
+		}
+		for _, v := range m.Positions[i*3 : i*3+3] {
+			if err := v.Write(out, binary.LittleEndian); err != nil {
+				return err
+			}
+		}
+		if m.Attributes != nil {
+			binary.LittleEndian.PutUint16(attribute, m.Attributes[i])
+		}
+		if _, err := out.Write(attribute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendVector(buf []byte, v vector3.Float32) []byte {
+	for i := range 3 {
+		buf = append(buf, ' ')
+		buf = strconv.AppendFloat(buf, float64(v.Component(i)), 'e', -1, 32)
+	}
+	return append(buf, '\n')
+}
+
+func (m Mesh) writeASCII(out *bufio.Writer, normals vector3.Float32Array) error {
+	if strings.ContainsAny(m.Name, "\r\n") {
+		return fmt.Errorf("stl name can not contain a line break: %q", m.Name)
+	}
+
+	fmt.Fprintf(out, "solid %s\n", m.Name)
+	var buf []byte
+	for i, normal := range normals {
+		buf = appendVector(append(buf[:0], "facet normal"...), normal)
+		buf = append(buf, "  outer loop\n"...)
+		for _, v := range m.Positions[i*3 : i*3+3] {
+			buf = appendVector(append(buf, "    vertex"...), v)
+		}
+		buf = append(buf, "  endloop\nendfacet\n"...)
+		if _, err := out.Write(buf); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(out, "endsolid %s\n", m.Name)
+	return err
+}
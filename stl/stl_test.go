@@ -0,0 +1,274 @@
+package stl_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/EliCDavis/vector/stl"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+const asciiTriangle = `solid tri
+facet normal 0 0 1
+  outer loop
+    vertex 0 0 0
+    vertex 1 0 0
+    vertex 0 1 0
+  endloop
+endfacet
+endsolid tri
+`
+
+// tetrahedron returns a closed mesh with its triangles wound counter
+// clockwise when viewed from outside
+func tetrahedron() stl.Mesh {
+	a := vector3.New[float32](0, 0, 0)
+	b := vector3.New[float32](1, 0, 0)
+	c := vector3.New[float32](0, 1, 0)
+	d := vector3.New[float32](0, 0, 1)
+	return stl.Mesh{
+		Name: "tetrahedron",
+		Positions: vector3.Float32Array{
+			a, c, b,
+			a, b, d,
+			a, d, c,
+			b, c, d,
+		},
+	}
+}
+
+func TestRead_ASCII(t *testing.T) {
+	m, err := stl.Read(strings.NewReader(asciiTriangle))
+	assert.NoError(t, err)
+	assert.Equal(t, "tri", m.Name)
+	assert.Equal(t, vector3.Float32Array{
+		vector3.New[float32](0, 0, 0),
+		vector3.New[float32](1, 0, 0),
+		vector3.New[float32](0, 1, 0),
+	}, m.Positions)
+	assert.Equal(t, vector3.Float32Array{vector3.Forward[float32]()}, m.Normals)
+	assert.Nil(t, m.Attributes)
+}
+
+func TestRead_ASCIIMultipleSolids(t *testing.T) {
+	input := asciiTriangle + strings.ReplaceAll(asciiTriangle, "tri", "other")
+	m, err := stl.Read(strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.Equal(t, "tri", m.Name)
+	assert.Len(t, m.Positions, 6)
+	assert.Len(t, m.Normals, 2)
+}
+
+func TestRead_ASCIIErrors(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"missing endsolid": {
+			input: strings.TrimSuffix(asciiTriangle, "endsolid tri\n"),
+			err:   `stl line 8: expected "endsolid", found the end of the file`,
+		},
+		"missing vertex": {
+			input: strings.Replace(asciiTriangle, "    vertex 0 1 0\n", "", 1),
+			err:   `stl line 6: expected "vertex"`,
+		},
+		"invalid number": {
+			input: strings.Replace(asciiTriangle, "vertex 1 0 0", "vertex 1 zero 0", 1),
+			err:   `stl line 5: invalid number: "zero"`,
+		},
+		"short normal": {
+			input: strings.Replace(asciiTriangle, "normal 0 0 1", "normal 0 1", 1),
+			err:   "stl line 2: expected 3 values, found 2",
+		},
+		"unexpected keyword": {
+			input: strings.Replace(asciiTriangle, "facet normal", "face normal", 1),
+			err:   `stl line 2: expected "facet" or "endsolid", found "face"`,
+		},
+		"empty": {
+			input: "solid\n",
+			err:   `stl line 1: expected "endsolid", found the end of the file`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := stl.Read(strings.NewReader(tc.input))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	for _, format := range []stl.Format{stl.ASCII, stl.Binary} {
+		m := tetrahedron()
+
+		buf := bytes.Buffer{}
+		assert.NoError(t, m.Write(&buf, format))
+
+		back, err := stl.Read(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, m.Name, back.Name)
+		assert.Equal(t, m.Positions, back.Positions)
+		assert.Equal(t, stl.FaceNormals(m.Positions), back.Normals)
+		assert.Nil(t, back.Attributes)
+	}
+}
+
+func TestWrite_RecomputesNormals(t *testing.T) {
+	m := tetrahedron()
+	m.Normals = make(vector3.Float32Array, 4)
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, m.Write(&buf, stl.Binary))
+
+	back, err := stl.Read(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.Float32Array{
+		vector3.New[float32](0, 0, -1),
+		vector3.New[float32](0, -1, 0),
+		vector3.New[float32](-1, 0, 0),
+	}, back.Normals[:3])
+
+	// Every normal of a closed mesh points away from its center
+	center := vector3.New[float32](0.25, 0.25, 0.25)
+	for i, n := range back.Normals {
+		assert.Greater(t, n.Dot(back.Positions[i*3].Sub(center)), float64(0))
+		assert.InDelta(t, 1, n.Length(), 1e-6)
+	}
+}
+
+func TestWrite_ASCII(t *testing.T) {
+	m := stl.Mesh{
+		Name: "tri",
+		Positions: vector3.Float32Array{
+			vector3.New[float32](0, 0, 0),
+			vector3.New[float32](1.5, 0, 0),
+			vector3.New[float32](0, 1, 0),
+		},
+	}
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, m.Write(&buf, stl.ASCII))
+	assert.Equal(t, `solid tri
+facet normal 0e+00 0e+00 1e+00
+  outer loop
+    vertex 0e+00 0e+00 0e+00
+    vertex 1.5e+00 0e+00 0e+00
+    vertex 0e+00 1e+00 0e+00
+  endloop
+endfacet
+endsolid tri
+`, buf.String())
+}
+
+func TestWrite_Binary(t *testing.T) {
+	m := tetrahedron()
+	m.Attributes = []uint16{1, 2, 3, 0x7FFF}
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, m.Write(&buf, stl.Binary))
+
+	data := buf.Bytes()
+	assert.Len(t, data, 84+4*50)
+	assert.Equal(t, "tetrahedron", string(data[:11]))
+	assert.Equal(t, make([]byte, 80-11), data[11:80])
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(data[80:]))
+
+	back, err := stl.Read(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, m.Attributes, back.Attributes)
+}
+
+func TestRead_BinaryStartingWithSolid(t *testing.T) {
+	m := tetrahedron()
+	m.Name = "solid exported as binary"
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, m.Write(&buf, stl.Binary))
+
+	back, err := stl.Read(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Name, back.Name)
+	assert.Equal(t, m.Positions, back.Positions)
+}
+
+func TestRead_BinaryTruncated(t *testing.T) {
+	buf := bytes.Buffer{}
+	assert.NoError(t, tetrahedron().Write(&buf, stl.Binary))
+	data := buf.Bytes()
+
+	for _, size := range []int{0, 40, 84 + 10, 84 + 48, 84 + 50 + 12} {
+		_, err := stl.Read(bytes.NewReader(data[:size]))
+		assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), "size %d: %v", size, err)
+	}
+
+	_, err := stl.Read(bytes.NewReader(data[:84+50+12]))
+	assert.EqualError(t, err, "stl triangle 1: unexpected EOF")
+}
+
+func TestWrite_Errors(t *testing.T) {
+	tests := map[string]struct {
+		mesh   stl.Mesh
+		format stl.Format
+		err    string
+	}{
+		"partial triangle": {
+			mesh:   stl.Mesh{Positions: make(vector3.Float32Array, 4)},
+			format: stl.Binary,
+			err:    "position count 4 is not a multiple of 3",
+		},
+		"attribute count": {
+			mesh:   stl.Mesh{Positions: make(vector3.Float32Array, 6), Attributes: []uint16{1}},
+			format: stl.Binary,
+			err:    "mesh has 2 triangles, but 1 attributes",
+		},
+		"long binary name": {
+			mesh:   stl.Mesh{Name: strings.Repeat("a", 81)},
+			format: stl.Binary,
+			err:    "stl name is 81 bytes long, binary headers hold at most 80",
+		},
+		"multi line ascii name": {
+			mesh:   stl.Mesh{Name: "a\nb"},
+			format: stl.ASCII,
+			err:    `stl name can not contain a line break: "a\nb"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, tc.mesh.Write(&bytes.Buffer{}, tc.format), tc.err)
+		})
+	}
+}
+
+func TestWrite_UnknownFormatPanics(t *testing.T) {
+	assert.PanicsWithError(t, "unknown stl format: 2", func() {
+		stl.Mesh{}.Write(&bytes.Buffer{}, stl.Format(2))
+	})
+}
+
+func TestFaceNormals(t *testing.T) {
+	normals := stl.FaceNormals(vector3.Float32Array{
+		vector3.New[float32](0, 0, 0),
+		vector3.New[float32](0, 2, 0),
+		vector3.New[float32](2, 0, 0),
+
+		// Degenerate triangles have no normal
+		vector3.New[float32](1, 1, 1),
+		vector3.New[float32](2, 2, 2),
+		vector3.New[float32](3, 3, 3),
+	})
+	assert.Equal(t, vector3.Float32Array{
+		vector3.New[float32](0, 0, -1),
+		vector3.Zero[float32](),
+	}, normals)
+
+	assert.PanicsWithError(t, "position count 2 is not a multiple of 3", func() {
+		stl.FaceNormals(make(vector3.Float32Array, 2))
+	})
+}
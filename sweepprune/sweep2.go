@@ -0,0 +1,99 @@
+package sweepprune
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// Sweep2 tracks which vector2 bounding boxes overlap one another. Boxes are
+// sorted along a single axis, and only boxes that overlap along that axis are
+// tested against each other. Changes made through Insert, Move and Remove
+// take effect on the next call to Update.
+type Sweep2[T vector.Number] struct {
+	axis     int
+	boxes    map[int]vector2.AABB[T]
+	sweep    sweepAxis[T]
+	overlaps map[Pair]struct{}
+}
+
+// NewSweep2 creates an empty broad phase that sorts boxes along the axis
+// provided, where X is 0 and Y is 1. Choosing the axis along which
+// boxes are most spread out produces the fewest false candidates.
+func NewSweep2[T vector.Number](axis int) *Sweep2[T] {
+	checkAxis(axis, 2)
+	return &Sweep2[T]{
+		axis:     axis,
+		boxes:    make(map[int]vector2.AABB[T]),
+		sweep:    newSweepAxis[T](),
+		overlaps: make(map[Pair]struct{}),
+	}
+}
+
+// Len returns the number of boxes being tracked
+func (s *Sweep2[T]) Len() int {
+	return len(s.boxes)
+}
+
+// Bounds returns the bounding box with the ID provided, and whether or not it
+// exists
+func (s *Sweep2[T]) Bounds(id int) (vector2.AABB[T], bool) {
+	box, ok := s.boxes[id]
+	return box, ok
+}
+
+// Insert begins tracking a bounding box. If a box with the same ID already
+// exists, it is moved instead.
+func (s *Sweep2[T]) Insert(id int, box vector2.AABB[T]) {
+	if s.Move(id, box) {
+		return
+	}
+	s.boxes[id] = box
+	s.sweep.insert(id)
+}
+
+// Move updates the bounding box with the ID provided, returning false if it
+// does not exist
+func (s *Sweep2[T]) Move(id int, box vector2.AABB[T]) bool {
+	if _, ok := s.boxes[id]; !ok {
+		return false
+	}
+	s.boxes[id] = box
+	return true
+}
+
+// Remove stops tracking the bounding box with the ID provided, returning
+// whether or not it existed. Any pairs it was a part of are reported as
+// removed on the next Update.
+func (s *Sweep2[T]) Remove(id int) bool {
+	if _, ok := s.boxes[id]; !ok {
+		return false
+	}
+	delete(s.boxes, id)
+	s.sweep.remove(id)
+	return true
+}
+
+// Update determines which boxes overlap after all changes made since the
+// last update, returning the pairs that began and stopped overlapping
+func (s *Sweep2[T]) Update() (added, removed []Pair) {
+	s.sweep.sort(func(id int) (T, T) {
+		box := s.boxes[id]
+		return box.Min().Component(s.axis), box.Max().Component(s.axis)
+	})
+
+	current := make(map[Pair]struct{}, len(s.overlaps))
+	for pair := range s.sweep.candidates {
+		if s.boxes[pair.A].Intersects(s.boxes[pair.B]) {
+			current[pair] = struct{}{}
+		}
+	}
+
+	added, removed = diff(s.overlaps, current)
+	s.overlaps = current
+	return
+}
+
+// Pairs returns every pair of boxes that overlapped as of the last Update
+func (s *Sweep2[T]) Pairs() []Pair {
+	return sortedPairs(s.overlaps)
+}
@@ -0,0 +1,86 @@
+package sweepprune_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/sweepprune"
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func bruteForcePairs2(boxes map[int]vector2.AABB[float64]) map[sweepprune.Pair]struct{} {
+	out := make(map[sweepprune.Pair]struct{})
+	for a, boxA := range boxes {
+		for b, boxB := range boxes {
+			if a < b && boxA.Intersects(boxB) {
+				out[sweepprune.Pair{A: a, B: b}] = struct{}{}
+			}
+		}
+	}
+	return out
+}
+
+func randomBox2(r *rand.Rand) vector2.AABB[float64] {
+	center := vector2.Rand(r).Scale(100).Sub(vector2.Fill(50.))
+	size := vector2.Rand(r).Scale(7).Add(vector2.One[float64]())
+	return vector2.NewAABBFromCenter(center, size)
+}
+
+func TestSweep2_MatchesBruteForce(t *testing.T) {
+	for axis := 0; axis < 2; axis++ {
+		t.Run(fmt.Sprintf("axis %d", axis), func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			sweep := sweepprune.NewSweep2[float64](axis)
+			boxes := make(map[int]vector2.AABB[float64])
+			previous := make(map[sweepprune.Pair]struct{})
+			nextID := 0
+
+			for frame := 0; frame < 30; frame++ {
+				for i := 0; i < 10; i++ {
+					boxes[nextID] = randomBox2(r)
+					sweep.Insert(nextID, boxes[nextID])
+					nextID++
+				}
+
+				for id, box := range boxes {
+					switch r.Intn(10) {
+					case 0:
+						boxes[id] = randomBox2(r)
+					case 1, 2, 3, 4, 5, 6:
+						offset := vector2.Rand(r).Scale(4).Sub(vector2.Fill(2.))
+						boxes[id] = vector2.NewAABB(box.Min().Add(offset), box.Max().Add(offset))
+					}
+					assert.True(t, sweep.Move(id, boxes[id]))
+				}
+
+				for id := range boxes {
+					if r.Intn(15) == 0 {
+						delete(boxes, id)
+						assert.True(t, sweep.Remove(id))
+					}
+				}
+
+				added, removed := sweep.Update()
+				current := bruteForcePairs2(boxes)
+				wantAdded, wantRemoved := expectedChanges(previous, current)
+
+				assert.Equal(t, len(boxes), sweep.Len())
+				assert.Equal(t, wantAdded, added)
+				assert.Equal(t, wantRemoved, removed)
+				assert.Equal(t, sortedKeys(current), sweep.Pairs())
+				previous = current
+			}
+		})
+	}
+}
+
+func TestSweep2_InvalidAxis(t *testing.T) {
+	assert.PanicsWithError(t, "invalid axis: 2", func() {
+		sweepprune.NewSweep2[float64](2)
+	})
+	assert.PanicsWithError(t, "invalid axis: -1", func() {
+		sweepprune.NewSweep2[float64](-1)
+	})
+}
@@ -0,0 +1,99 @@
+package sweepprune
+
+import (
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+)
+
+// Sweep3 tracks which vector3 bounding boxes overlap one another. Boxes are
+// sorted along a single axis, and only boxes that overlap along that axis are
+// tested against each other. Changes made through Insert, Move and Remove
+// take effect on the next call to Update.
+type Sweep3[T vector.Number] struct {
+	axis     int
+	boxes    map[int]vector3.AABB[T]
+	sweep    sweepAxis[T]
+	overlaps map[Pair]struct{}
+}
+
+// NewSweep3 creates an empty broad phase that sorts boxes along the axis
+// provided, where X is 0, Y is 1 and Z is 2. Choosing the axis along which
+// boxes are most spread out produces the fewest false candidates.
+func NewSweep3[T vector.Number](axis int) *Sweep3[T] {
+	checkAxis(axis, 3)
+	return &Sweep3[T]{
+		axis:     axis,
+		boxes:    make(map[int]vector3.AABB[T]),
+		sweep:    newSweepAxis[T](),
+		overlaps: make(map[Pair]struct{}),
+	}
+}
+
+// Len returns the number of boxes being tracked
+func (s *Sweep3[T]) Len() int {
+	return len(s.boxes)
+}
+
+// Bounds returns the bounding box with the ID provided, and whether or not it
+// exists
+func (s *Sweep3[T]) Bounds(id int) (vector3.AABB[T], bool) {
+	box, ok := s.boxes[id]
+	return box, ok
+}
+
+// Insert begins tracking a bounding box. If a box with the same ID already
+// exists, it is moved instead.
+func (s *Sweep3[T]) Insert(id int, box vector3.AABB[T]) {
+	if s.Move(id, box) {
+		return
+	}
+	s.boxes[id] = box
+	s.sweep.insert(id)
+}
+
+// Move updates the bounding box with the ID provided, returning false if it
+// does not exist
+func (s *Sweep3[T]) Move(id int, box vector3.AABB[T]) bool {
+	if _, ok := s.boxes[id]; !ok {
+		return false
+	}
+	s.boxes[id] = box
+	return true
+}
+
+// Remove stops tracking the bounding box with the ID provided, returning
+// whether or not it existed. Any pairs it was a part of are reported as
+// removed on the next Update.
+func (s *Sweep3[T]) Remove(id int) bool {
+	if _, ok := s.boxes[id]; !ok {
+		return false
+	}
+	delete(s.boxes, id)
+	s.sweep.remove(id)
+	return true
+}
+
+// Update determines which boxes overlap after all changes made since the
+// last update, returning the pairs that began and stopped overlapping
+func (s *Sweep3[T]) Update() (added, removed []Pair) {
+	s.sweep.sort(func(id int) (T, T) {
+		box := s.boxes[id]
+		return box.Min().Component(s.axis), box.Max().Component(s.axis)
+	})
+
+	current := make(map[Pair]struct{}, len(s.overlaps))
+	for pair := range s.sweep.candidates {
+		if s.boxes[pair.A].Intersects(s.boxes[pair.B]) {
+			current[pair] = struct{}{}
+		}
+	}
+
+	added, removed = diff(s.overlaps, current)
+	s.overlaps = current
+	return
+}
+
+// Pairs returns every pair of boxes that overlapped as of the last Update
+func (s *Sweep3[T]) Pairs() []Pair {
+	return sortedPairs(s.overlaps)
+}
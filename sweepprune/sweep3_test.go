@@ -0,0 +1,180 @@
+package sweepprune_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/EliCDavis/vector/sweepprune"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func bruteForcePairs3(boxes map[int]vector3.AABB[float64]) map[sweepprune.Pair]struct{} {
+	out := make(map[sweepprune.Pair]struct{})
+	for a, boxA := range boxes {
+		for b, boxB := range boxes {
+			if a < b && boxA.Intersects(boxB) {
+				out[sweepprune.Pair{A: a, B: b}] = struct{}{}
+			}
+		}
+	}
+	return out
+}
+
+func sortedKeys(pairs map[sweepprune.Pair]struct{}) []sweepprune.Pair {
+	out := make([]sweepprune.Pair, 0, len(pairs))
+	for pair := range pairs {
+		out = append(out, pair)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].A != out[j].A {
+			return out[i].A < out[j].A
+		}
+		return out[i].B < out[j].B
+	})
+	return out
+}
+
+func expectedChanges(previous, current map[sweepprune.Pair]struct{}) (added, removed []sweepprune.Pair) {
+	addedSet := make(map[sweepprune.Pair]struct{})
+	removedSet := make(map[sweepprune.Pair]struct{})
+	for pair := range current {
+		if _, ok := previous[pair]; !ok {
+			addedSet[pair] = struct{}{}
+		}
+	}
+	for pair := range previous {
+		if _, ok := current[pair]; !ok {
+			removedSet[pair] = struct{}{}
+		}
+	}
+	return sortedKeys(addedSet), sortedKeys(removedSet)
+}
+
+func randomBox3(r *rand.Rand) vector3.AABB[float64] {
+	return vector3.NewAABBFromCenter(vector3.RandRange(r, -50., 50.), vector3.RandRange(r, 1., 8.))
+}
+
+func TestSweep3_MatchesBruteForce(t *testing.T) {
+	for axis := 0; axis < 3; axis++ {
+		t.Run(fmt.Sprintf("axis %d", axis), func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			sweep := sweepprune.NewSweep3[float64](axis)
+			boxes := make(map[int]vector3.AABB[float64])
+			previous := make(map[sweepprune.Pair]struct{})
+			nextID := 0
+
+			for frame := 0; frame < 30; frame++ {
+				// Spawn
+				for i := 0; i < 20; i++ {
+					boxes[nextID] = randomBox3(r)
+					sweep.Insert(nextID, boxes[nextID])
+					nextID++
+				}
+
+				// Move most boxes a little, and teleport a few
+				for id, box := range boxes {
+					switch r.Intn(10) {
+					case 0:
+						boxes[id] = randomBox3(r)
+					case 1, 2, 3, 4, 5, 6:
+						offset := vector3.RandRange(r, -2., 2.)
+						boxes[id] = vector3.NewAABB(box.Min().Add(offset), box.Max().Add(offset))
+					}
+					assert.True(t, sweep.Move(id, boxes[id]))
+				}
+
+				// Despawn
+				for id := range boxes {
+					if r.Intn(15) == 0 {
+						delete(boxes, id)
+						assert.True(t, sweep.Remove(id))
+					}
+				}
+
+				added, removed := sweep.Update()
+				current := bruteForcePairs3(boxes)
+				wantAdded, wantRemoved := expectedChanges(previous, current)
+
+				assert.Equal(t, len(boxes), sweep.Len())
+				assert.Equal(t, wantAdded, added)
+				assert.Equal(t, wantRemoved, removed)
+				assert.Equal(t, sortedKeys(current), sweep.Pairs())
+				previous = current
+			}
+		})
+	}
+}
+
+func TestSweep3_TouchingBoxesOverlap(t *testing.T) {
+	sweep := sweepprune.NewSweep3[int](0)
+	sweep.Insert(1, vector3.NewAABB(vector3.New(0, 0, 0), vector3.New(1, 1, 1)))
+	sweep.Insert(2, vector3.NewAABB(vector3.New(1, 0, 0), vector3.New(2, 1, 1)))
+	sweep.Insert(3, vector3.NewAABB(vector3.New(0, 2, 0), vector3.New(2, 3, 1)))
+
+	added, removed := sweep.Update()
+	assert.Equal(t, []sweepprune.Pair{{A: 1, B: 2}}, added)
+	assert.Empty(t, removed)
+
+	// Nothing changed
+	added, removed = sweep.Update()
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	sweep.Move(3, vector3.NewAABB(vector3.New(0, 1, 0), vector3.New(2, 3, 1)))
+	sweep.Remove(2)
+	added, removed = sweep.Update()
+	assert.Equal(t, []sweepprune.Pair{{A: 1, B: 3}}, added)
+	assert.Equal(t, []sweepprune.Pair{{A: 1, B: 2}}, removed)
+}
+
+func TestSweep3_InsertReplaces(t *testing.T) {
+	sweep := sweepprune.NewSweep3[float64](1)
+	sweep.Insert(4, vector3.NewAABB(vector3.Zero[float64](), vector3.One[float64]()))
+	sweep.Insert(4, vector3.NewAABB(vector3.Fill(5.), vector3.Fill(6.)))
+	assert.Equal(t, 1, sweep.Len())
+
+	box, ok := sweep.Bounds(4)
+	assert.True(t, ok)
+	assert.Equal(t, vector3.Fill(5.), box.Min())
+
+	assert.False(t, sweep.Move(7, box))
+	assert.False(t, sweep.Remove(7))
+	_, ok = sweep.Bounds(7)
+	assert.False(t, ok)
+}
+
+func TestSweep3_InvalidAxis(t *testing.T) {
+	assert.PanicsWithError(t, "invalid axis: 3", func() {
+		sweepprune.NewSweep3[float64](3)
+	})
+}
+
+var updateResult int
+
+func BenchmarkSweep3_Update(b *testing.B) {
+	for _, testLen := range []int{1_000, 10_000} {
+		r := rand.New(rand.NewSource(42))
+		sweep := sweepprune.NewSweep3[float64](0)
+		boxes := make([]vector3.AABB[float64], testLen)
+		for i := range boxes {
+			boxes[i] = vector3.NewAABBFromCenter(vector3.RandRange(r, -100., 100.), vector3.RandRange(r, 0.5, 2.))
+			sweep.Insert(i, boxes[i])
+		}
+		sweep.Update()
+
+		b.Run(fmt.Sprintf("Len-%d", testLen), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i, box := range boxes {
+					offset := vector3.RandRange(r, -0.1, 0.1)
+					boxes[i] = vector3.NewAABB(box.Min().Add(offset), box.Max().Add(offset))
+					sweep.Move(i, boxes[i])
+				}
+				added, removed := sweep.Update()
+				updateResult = len(added) + len(removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package sweepprune provides incremental sweep and prune broad phases, which
+// track the overlapping pairs among many moving vector2 and vector3 bounding
+// boxes.
+package sweepprune
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/EliCDavis/vector"
+)
+
+// Pair is two bounding boxes that overlap. A is always the smaller of the two
+// IDs.
+type Pair struct {
+	A, B int
+}
+
+func newPair(a, b int) Pair {
+	if a > b {
+		return Pair{A: b, B: a}
+	}
+	return Pair{A: a, B: b}
+}
+
+func sortPairs(pairs []Pair) {
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
+		}
+		return pairs[i].B < pairs[j].B
+	})
+}
+
+func checkAxis(axis, dimensions int) {
+	if axis < 0 || axis >= dimensions {
+		panic(fmt.Errorf("invalid axis: %d", axis))
+	}
+}
+
+type endpoint[T vector.Number] struct {
+	value T
+	id    int
+	max   bool
+}
+
+// less orders endpoints by value, with minimums coming before maximums of
+// the same value so boxes that touch are considered overlapping
+func (e endpoint[T]) less(other endpoint[T]) bool {
+	return e.value < other.value || (e.value == other.value && !e.max && other.max)
+}
+
+// sweepAxis keeps the endpoints of every box along a single axis sorted,
+// tracking which pairs of boxes overlap along it as endpoints swap places.
+// Sorting with insertion sort is close to linear when boxes have only moved
+// a little since the last sort.
+type sweepAxis[T vector.Number] struct {
+	endpoints  []endpoint[T]
+	candidates map[Pair]struct{}
+}
+
+func newSweepAxis[T vector.Number]() sweepAxis[T] {
+	return sweepAxis[T]{
+		endpoints:  make([]endpoint[T], 0),
+		candidates: make(map[Pair]struct{}),
+	}
+}
+
+// insert adds the endpoints of a box to the end of the list, as if the box
+// were beyond every other box. The next sort moves them into place.
+func (s *sweepAxis[T]) insert(id int) {
+	s.endpoints = append(s.endpoints, endpoint[T]{id: id}, endpoint[T]{id: id, max: true})
+}
+
+func (s *sweepAxis[T]) remove(id int) {
+	kept := s.endpoints[:0]
+	for _, e := range s.endpoints {
+		if e.id != id {
+			kept = append(kept, e)
+		}
+	}
+	s.endpoints = kept
+
+	for pair := range s.candidates {
+		if pair.A == id || pair.B == id {
+			delete(s.candidates, pair)
+		}
+	}
+}
+
+// sort refreshes the value of every endpoint and restores their order
+func (s *sweepAxis[T]) sort(extents func(id int) (T, T)) {
+	for i, e := range s.endpoints {
+		lo, hi := extents(e.id)
+		if e.max {
+			s.endpoints[i].value = hi
+		} else {
+			s.endpoints[i].value = lo
+		}
+	}
+
+	for i := 1; i < len(s.endpoints); i++ {
+		for j := i; j > 0 && s.endpoints[j].less(s.endpoints[j-1]); j-- {
+			moving := s.endpoints[j]
+			passed := s.endpoints[j-1]
+
+			if !moving.max && passed.max {
+				// A minimum moving before a maximum starts an overlap
+				s.candidates[newPair(moving.id, passed.id)] = struct{}{}
+			} else if moving.max && !passed.max {
+				// A maximum moving before a minimum ends one
+				delete(s.candidates, newPair(moving.id, passed.id))
+			}
+
+			s.endpoints[j], s.endpoints[j-1] = passed, moving
+		}
+	}
+}
+
+// diff replaces the previous set of overlaps with the current set, returning
+// what changed between the two in sorted order
+func diff(previous, current map[Pair]struct{}) (added, removed []Pair) {
+	added = make([]Pair, 0)
+	removed = make([]Pair, 0)
+
+	for pair := range current {
+		if _, ok := previous[pair]; !ok {
+			added = append(added, pair)
+		}
+	}
+
+	for pair := range previous {
+		if _, ok := current[pair]; !ok {
+			removed = append(removed, pair)
+		}
+	}
+
+	sortPairs(added)
+	sortPairs(removed)
+	return
+}
+
+func sortedPairs(overlaps map[Pair]struct{}) []Pair {
+	out := make([]Pair, 0, len(overlaps))
+	for pair := range overlaps {
+		out = append(out, pair)
+	}
+	sortPairs(out)
+	return out
+}
@@ -0,0 +1,185 @@
+package vector2
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// AABB is an axis aligned bounding box, described by its minimum and maximum
+// corners
+type AABB[T vector.Number] struct {
+	min Vector[T]
+	max Vector[T]
+}
+
+// NewAABB creates the smallest bounding box containing both points
+func NewAABB[T vector.Number](a, b Vector[T]) AABB[T] {
+	return AABB[T]{
+		min: Min(a, b),
+		max: Max(a, b),
+	}
+}
+
+// NewAABBFromCenter creates a bounding box centered at the point provided,
+// extending out by half of size in each direction
+func NewAABBFromCenter[T vector.Number](center, size Vector[T]) AABB[T] {
+	half := size.Abs().Scale(0.5)
+	return AABB[T]{
+		min: center.Sub(half),
+		max: center.Add(half),
+	}
+}
+
+// Min returns the minimum corner of the bounding box
+func (b AABB[T]) Min() Vector[T] {
+	return b.min
+}
+
+// Max returns the maximum corner of the bounding box
+func (b AABB[T]) Max() Vector[T] {
+	return b.max
+}
+
+// Center returns the point in the middle of the bounding box
+func (b AABB[T]) Center() Vector[T] {
+	return Midpoint(b.min, b.max)
+}
+
+// Size returns the length of the bounding box along each axis
+func (b AABB[T]) Size() Vector[T] {
+	return b.max.Sub(b.min)
+}
+
+// Area returns the area of the bounding box
+func (b AABB[T]) Area() float64 {
+	size := b.Size().ToFloat64()
+	return size.x * size.y
+}
+
+// Perimeter returns the total length of the 4 edges of the bounding box
+func (b AABB[T]) Perimeter() float64 {
+	size := b.Size().ToFloat64()
+	return 2 * (size.x + size.y)
+}
+
+// LongestAxis returns the index of the component along which the bounding box
+// is the largest
+func (b AABB[T]) LongestAxis() int {
+	size := b.Size()
+	if size.x >= size.y {
+		return 0
+	}
+	return 1
+}
+
+// ToFloat64 converts the bounding box to be represented by float64 vectors
+func (b AABB[T]) ToFloat64() AABB[float64] {
+	return AABB[float64]{
+		min: b.min.ToFloat64(),
+		max: b.max.ToFloat64(),
+	}
+}
+
+// Contains returns true if the point lies inside or on the edge of the
+// bounding box
+func (b AABB[T]) Contains(p Vector[T]) bool {
+	return p.x >= b.min.x && p.x <= b.max.x &&
+		p.y >= b.min.y && p.y <= b.max.y
+}
+
+// ContainsAABB returns true if the other bounding box lies entirely within
+// this one
+func (b AABB[T]) ContainsAABB(other AABB[T]) bool {
+	return b.Contains(other.min) && b.Contains(other.max)
+}
+
+// Intersects returns true if the two bounding boxes overlap or touch
+func (b AABB[T]) Intersects(other AABB[T]) bool {
+	return b.min.x <= other.max.x && b.max.x >= other.min.x &&
+		b.min.y <= other.max.y && b.max.y >= other.min.y
+}
+
+// Union returns the smallest bounding box containing both bounding boxes
+func (b AABB[T]) Union(other AABB[T]) AABB[T] {
+	return AABB[T]{
+		min: Min(b.min, other.min),
+		max: Max(b.max, other.max),
+	}
+}
+
+// Include returns the smallest bounding box containing both this bounding
+// box and the point provided
+func (b AABB[T]) Include(p Vector[T]) AABB[T] {
+	return AABB[T]{
+		min: Min(b.min, p),
+		max: Max(b.max, p),
+	}
+}
+
+// Expand returns a bounding box grown by amount in every direction
+func (b AABB[T]) Expand(amount T) AABB[T] {
+	grow := Fill(amount)
+	return AABB[T]{
+		min: b.min.Sub(grow),
+		max: b.max.Add(grow),
+	}
+}
+
+// ClosestPoint returns the point within the bounding box closest to the
+// point provided
+func (b AABB[T]) ClosestPoint(p Vector[T]) Vector[T] {
+	return Max(b.min, Min(b.max, p))
+}
+
+// DistanceSquared returns the squared distance between the point provided and
+// the closest point within the bounding box. Points inside the bounding box
+// have a distance of 0.
+func (b AABB[T]) DistanceSquared(p Vector[T]) float64 {
+	return b.ClosestPoint(p).ToFloat64().DistanceSquared(p.ToFloat64())
+}
+
+// IntersectsCircle returns true if any part of the circle lies inside the
+// bounding box
+func (b AABB[T]) IntersectsCircle(center Vector[T], radius float64) bool {
+	return b.DistanceSquared(center) <= radius*radius
+}
+
+// IntersectRay tests the ray against the bounding box using the slab method.
+// If the ray hits, the distances along the ray at which it enters and exits
+// the bounding box are returned. A ray starting inside the bounding box will
+// have a negative entry distance.
+func (b AABB[T]) IntersectRay(origin, direction Vector[float64]) (tMin, tMax float64, hit bool) {
+	tMin = math.Inf(-1)
+	tMax = math.Inf(1)
+
+	bMin := b.min.ToFloat64().ToFixedArr()
+	bMax := b.max.ToFloat64().ToFixedArr()
+	o := origin.ToFixedArr()
+	d := direction.ToFixedArr()
+
+	for axis := 0; axis < 2; axis++ {
+		if d[axis] == 0 {
+			// Parallel to the slab, and must already be within it
+			if o[axis] < bMin[axis] || o[axis] > bMax[axis] {
+				return 0, 0, false
+			}
+			continue
+		}
+
+		inv := 1. / d[axis]
+		t1 := (bMin[axis] - o[axis]) * inv
+		t2 := (bMax[axis] - o[axis]) * inv
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+
+		tMin = math.Max(tMin, t1)
+		tMax = math.Min(tMax, t2)
+		if tMin > tMax {
+			return 0, 0, false
+		}
+	}
+
+	return tMin, tMax, tMax >= 0
+}
@@ -0,0 +1,113 @@
+package vector2_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAABB(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(2., -1.), vector2.New(-2., 3.))
+
+	assert.Equal(t, vector2.New(-2., -1.), box.Min())
+	assert.Equal(t, vector2.New(2., 3.), box.Max())
+	assert.Equal(t, vector2.New(0., 1.), box.Center())
+	assert.Equal(t, vector2.New(4., 4.), box.Size())
+	assert.InDelta(t, 16, box.Area(), 0.000001)
+	assert.InDelta(t, 16, box.Perimeter(), 0.000001)
+	assert.Equal(t, box, vector2.NewAABBFromCenter(vector2.New(0., 1.), vector2.New(-4., 4.)))
+	assert.Equal(t, box, vector2.NewAABB(vector2.Float64Array{
+		vector2.New(-2., 3.),
+		vector2.New(2., -1.),
+	}.Bounds()))
+
+	assert.Equal(t, 0, vector2.NewAABB(vector2.Zero[int](), vector2.New(3, 2)).LongestAxis())
+	assert.Equal(t, 1, vector2.NewAABB(vector2.Zero[int](), vector2.New(2, 3)).LongestAxis())
+	assert.Equal(t, vector2.New(1., 2.), vector2.NewAABB(vector2.New(1, 2), vector2.New(3, 4)).ToFloat64().Min())
+}
+
+func TestAABB_Containment(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(0, 0), vector2.New(10, 10))
+
+	assert.True(t, box.Contains(vector2.New(5, 5)))
+	assert.True(t, box.Contains(vector2.New(10, 0)))
+	assert.False(t, box.Contains(vector2.New(11, 5)))
+
+	assert.True(t, box.ContainsAABB(vector2.NewAABB(vector2.New(1, 1), vector2.New(9, 9))))
+	assert.False(t, box.ContainsAABB(vector2.NewAABB(vector2.New(1, 1), vector2.New(11, 9))))
+
+	assert.True(t, box.Intersects(vector2.NewAABB(vector2.New(10, 10), vector2.New(20, 20))))
+	assert.False(t, box.Intersects(vector2.NewAABB(vector2.New(11, 0), vector2.New(20, 20))))
+}
+
+func TestAABB_Growth(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(0., 0.), vector2.New(1., 1.))
+
+	union := box.Union(vector2.NewAABB(vector2.New(2., -1.), vector2.New(3., 0.)))
+	assert.Equal(t, vector2.New(0., -1.), union.Min())
+	assert.Equal(t, vector2.New(3., 1.), union.Max())
+
+	include := box.Include(vector2.New(-1., 0.5))
+	assert.Equal(t, vector2.New(-1., 0.), include.Min())
+	assert.Equal(t, vector2.New(1., 1.), include.Max())
+
+	expand := box.Expand(1)
+	assert.Equal(t, vector2.New(-1., -1.), expand.Min())
+	assert.Equal(t, vector2.New(2., 2.), expand.Max())
+}
+
+func TestAABB_Distance(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(0., 0.), vector2.New(1., 1.))
+
+	assert.Equal(t, vector2.New(1., 0.), box.ClosestPoint(vector2.New(3., -2.)))
+	assert.InDelta(t, 8, box.DistanceSquared(vector2.New(3., -2.)), 0.000001)
+	assert.InDelta(t, 0, box.DistanceSquared(vector2.New(0.5, 0.5)), 0.000001)
+
+	assert.True(t, box.IntersectsCircle(vector2.New(3., 0.5), 2))
+	assert.False(t, box.IntersectsCircle(vector2.New(3., 0.5), 1.9))
+}
+
+func TestAABB_IntersectRay(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(-1., -1.), vector2.New(1., 1.))
+
+	tests := map[string]struct {
+		origin    vector2.Float64
+		direction vector2.Float64
+		hit       bool
+		tMin      float64
+		tMax      float64
+	}{
+		"straight on": {
+			origin: vector2.New(-5., 0.), direction: vector2.Right[float64](),
+			hit: true, tMin: 4, tMax: 6,
+		},
+		"from inside": {
+			origin: vector2.Zero[float64](), direction: vector2.Up[float64](),
+			hit: true, tMin: -1, tMax: 1,
+		},
+		"pointing away": {
+			origin: vector2.New(-5., 0.), direction: vector2.Left[float64](),
+			hit: false,
+		},
+		"parallel outside": {
+			origin: vector2.New(-5., 2.), direction: vector2.Right[float64](),
+			hit: false,
+		},
+		"diagonal": {
+			origin: vector2.New(-2., -2.), direction: vector2.One[float64](),
+			hit: true, tMin: 1, tMax: 3,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tMin, tMax, hit := box.IntersectRay(tc.origin, tc.direction)
+			assert.Equal(t, tc.hit, hit)
+			if tc.hit {
+				assert.InDelta(t, tc.tMin, tMin, 0.000001)
+				assert.InDelta(t, tc.tMax, tMax, 0.000001)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package vector2
+
+import "math"
+
+// FromPolar builds a cartesian vector from a radius and an angle in radians,
+// measured counter clockwise from the positive X axis
+func FromPolar(radius, angle float64) Float64 {
+	return Float64{
+		x: radius * math.Cos(angle),
+		y: radius * math.Sin(angle),
+	}
+}
+
+// ToPolar converts the vector into polar coordinates, returned as
+// (radius, angle). The angle is in radians, measured counter clockwise from
+// the positive X axis, and falls within (-Pi, Pi].
+func (v Vector[T]) ToPolar() Float64 {
+	x := float64(v.x)
+	y := float64(v.y)
+	angle := math.Atan2(y, x)
+	if angle == -math.Pi {
+		angle = math.Pi
+	}
+	return Float64{
+		x: math.Hypot(x, y),
+		y: angle,
+	}
+}
@@ -0,0 +1,49 @@
+package vector2_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPolar(t *testing.T) {
+	tests := map[string]struct {
+		v      vector2.Float64
+		radius float64
+		angle  float64
+	}{
+		"right":   {v: vector2.New(2., 0.), radius: 2, angle: 0},
+		"up":      {v: vector2.New(0., 3.), radius: 3, angle: math.Pi / 2},
+		"left":    {v: vector2.New(-1., 0.), radius: 1, angle: math.Pi},
+		"left -0": {v: vector2.New(-1., math.Copysign(0, -1)), radius: 1, angle: math.Pi},
+		"down":    {v: vector2.New(0., -1.), radius: 1, angle: -math.Pi / 2},
+		"3 4 5":   {v: vector2.New(3., 4.), radius: 5, angle: math.Atan2(4, 3)},
+		"origin":  {v: vector2.Zero[float64](), radius: 0, angle: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			polar := tc.v.ToPolar()
+			assert.InDelta(t, tc.radius, polar.X(), 0.000001)
+			assert.InDelta(t, tc.angle, polar.Y(), 0.000001)
+
+			back := vector2.FromPolar(polar.X(), polar.Y())
+			assert.InDelta(t, tc.v.X(), back.X(), 0.000001)
+			assert.InDelta(t, tc.v.Y(), back.Y(), 0.000001)
+		})
+	}
+}
+
+func TestPolarRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		v := vector2.New(r.Float64()*20-10, r.Float64()*20-10)
+		polar := v.ToPolar()
+		back := vector2.FromPolar(polar.X(), polar.Y())
+		assert.InDelta(t, v.X(), back.X(), 0.000001)
+		assert.InDelta(t, v.Y(), back.Y(), 0.000001)
+	}
+}
@@ -0,0 +1,130 @@
+package vector2_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMortonEncode(t *testing.T) {
+	tests := map[string]struct {
+		v    vector2.Int
+		code uint64
+	}{
+		"origin": {v: vector2.New(0, 0), code: 0},
+		"x":      {v: vector2.New(1, 0), code: 1},
+		"y":      {v: vector2.New(0, 1), code: 2},
+		"xy":     {v: vector2.New(3, 3), code: 15},
+		"max":    {v: vector2.Fill(1<<vector2.CurveBits - 1), code: 1<<64 - 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.code, vector2.MortonEncode(tc.v))
+			assert.Equal(t, tc.v, vector2.MortonDecode(tc.code))
+		})
+	}
+}
+
+func TestCurve_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, curve := range []vector2.Curve{vector2.MortonCurve, vector2.HilbertCurve} {
+		for i := 0; i < 1000; i++ {
+			v := vector2.New(r.Intn(1<<vector2.CurveBits), r.Intn(1<<vector2.CurveBits))
+			assert.Equal(t, v, curve.Decode(curve.Encode(v)))
+		}
+	}
+}
+
+func TestHilbert_ConsecutiveCellsAreAdjacent(t *testing.T) {
+	previous := vector2.HilbertDecode(0)
+	assert.Equal(t, vector2.Zero[int](), previous)
+
+	seen := make(map[vector2.Int]bool)
+	seen[previous] = true
+	for code := uint64(1); code < 1<<12; code++ {
+		current := vector2.HilbertDecode(code)
+		diff := current.Sub(previous).Abs()
+		assert.Equal(t, 1, diff.X()+diff.Y(), "code %d", code)
+		assert.False(t, seen[current])
+		seen[current] = true
+		previous = current
+	}
+}
+
+func TestCurve_Panics(t *testing.T) {
+	assert.PanicsWithError(t, "component out of range for a 32 bit curve: -1", func() {
+		vector2.MortonEncode(vector2.New(0, -1))
+	})
+	assert.PanicsWithError(t, "component out of range for a 32 bit curve: 4294967296", func() {
+		vector2.HilbertEncode(vector2.New(0, 1<<32))
+	})
+	assert.PanicsWithError(t, "unknown curve: 5", func() {
+		vector2.Curve(5).Decode(0)
+	})
+	assert.PanicsWithError(t, "invalid quantization bits: 0", func() {
+		vector2.NewAABB(vector2.Zero[float64](), vector2.One[float64]()).Quantize(vector2.Zero[float64](), 0)
+	})
+}
+
+func TestAABB_Quantize(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(-1., 0.), vector2.New(1., 4.))
+
+	tests := map[string]struct {
+		v    vector2.Float64
+		bits int
+		want vector2.Int
+	}{
+		"min corner": {v: vector2.New(-1., 0.), bits: 2, want: vector2.New(0, 0)},
+		"max corner": {v: vector2.New(1., 4.), bits: 2, want: vector2.New(3, 3)},
+		"middle":     {v: vector2.New(0., 2.), bits: 2, want: vector2.New(2, 2)},
+		"just below": {v: vector2.New(-0.01, 1.99), bits: 2, want: vector2.New(1, 1)},
+		"clamped":    {v: vector2.New(-5., 50.), bits: 4, want: vector2.New(0, 15)},
+		"full range": {v: vector2.New(1., 4.), bits: 32, want: vector2.New(1<<32-1, 1<<32-1)},
+		"huge":       {v: vector2.New(1e300, -1e300), bits: 32, want: vector2.New(1<<32-1, 0)},
+		"infinite":   {v: vector2.New(math.Inf(1), math.Inf(-1)), bits: 4, want: vector2.New(15, 0)},
+		"nan":        {v: vector2.New(math.NaN(), 2.), bits: 2, want: vector2.New(0, 2)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, box.Quantize(tc.v, tc.bits))
+		})
+	}
+}
+
+func TestAABB_Dequantize(t *testing.T) {
+	box := vector2.NewAABB(vector2.New(-1., 0.), vector2.New(1., 4.))
+	assert.Equal(t, vector2.New(-0.75, 0.5), box.Dequantize(vector2.New(0, 0), 2))
+	assert.Equal(t, vector2.New(0.75, 3.5), box.Dequantize(vector2.New(3, 3), 2))
+}
+
+func TestArray_SortByCurve(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, curve := range []vector2.Curve{vector2.MortonCurve, vector2.HilbertCurve} {
+		original := make(vector2.Float64Array, 500)
+		for i := range original {
+			original[i] = vector2.Rand(r).Scale(20).Sub(vector2.Fill(10.))
+		}
+		bounds := vector2.NewAABB(original.Bounds())
+
+		sorted := make(vector2.Float64Array, len(original))
+		copy(sorted, original)
+		permutation := sorted.SortByCurve(curve)
+
+		assert.Len(t, permutation, len(original))
+		for i, p := range permutation {
+			assert.Equal(t, original[p], sorted[i])
+		}
+		for i := 1; i < len(sorted); i++ {
+			previous := curve.Encode(bounds.Quantize(sorted[i-1], vector2.CurveBits))
+			current := curve.Encode(bounds.Quantize(sorted[i], vector2.CurveBits))
+			assert.LessOrEqual(t, previous, current)
+		}
+	}
+
+	assert.Len(t, vector2.Float64Array{}.SortByCurve(vector2.HilbertCurve), 0)
+}
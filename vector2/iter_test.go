@@ -0,0 +1,77 @@
+package vector2_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_All(t *testing.T) {
+	arr := vector2.Float64Array{vector2.New(1., 2.), vector2.New(3., 4.), vector2.New(5., 6.)}
+
+	indices := make([]int, 0)
+	values := make(vector2.Float64Array, 0)
+	for i, v := range arr.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, arr, values)
+	assert.Equal(t, arr, vector2.Collect(arr.Values()))
+}
+
+func TestArray_SegmentsAndWindows(t *testing.T) {
+	arr := vector2.IntArray{vector2.New(0, 0), vector2.New(3, 4), vector2.New(3, 10)}
+
+	total := 0.
+	for a, b := range arr.Segments() {
+		total += a.Distance(b)
+	}
+	assert.InDelta(t, 11., total, 0.000001)
+
+	windows := make([]vector2.IntArray, 0)
+	for w := range arr.Windows(2) {
+		windows = append(windows, w)
+	}
+	assert.Equal(t, []vector2.IntArray{arr[0:2], arr[1:3]}, windows)
+
+	assert.PanicsWithError(t, "invalid window size: -1", func() {
+		arr.Windows(-1)
+	})
+}
+
+func TestMap(t *testing.T) {
+	arr := vector2.Float64Array{vector2.New(1.2, -2.7), vector2.New(4., 5.5)}
+	rounded := vector2.Collect(vector2.Map(arr.Values(), vector2.Float64.RoundToInt))
+	assert.Equal(t, vector2.IntArray{vector2.New(1, -3), vector2.New(4, 6)}, rounded)
+}
+
+func TestGrid(t *testing.T) {
+	bounds := vector2.NewAABB(vector2.New(0., 0.), vector2.New(2., 4.))
+
+	points := vector2.Collect(vector2.Grid(bounds, vector2.New(3, 1)))
+	assert.Equal(t, vector2.Float64Array{
+		vector2.New(0., 2.),
+		vector2.New(1., 2.),
+		vector2.New(2., 2.),
+	}, points)
+
+	assert.Len(t, vector2.Collect(vector2.Grid(bounds, vector2.New(10, 20))), 200)
+
+	assert.PanicsWithError(t, "invalid grid counts: {-1 1}", func() {
+		vector2.Grid(bounds, vector2.New(-1, 1))
+	})
+}
+
+func TestRandSamples(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bounds := vector2.NewAABB(vector2.New(-1., 2.), vector2.New(1., 5.))
+
+	samples := vector2.Collect(vector2.RandSamples(r, bounds, 500))
+	assert.Len(t, samples, 500)
+	for _, s := range samples {
+		assert.True(t, bounds.Contains(s))
+	}
+}
@@ -0,0 +1,135 @@
+package vector2_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomArray(r *rand.Rand, n int) vector2.Float64Array {
+	arr := make(vector2.Float64Array, n)
+	for i := range arr {
+		arr[i] = vector2.Rand(r).Scale(20).Sub(vector2.Fill(10.))
+	}
+	return arr
+}
+
+func TestSoA_RoundTrip(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+	assert.Equal(t, len(arr), soa.Len())
+	assert.Len(t, soa.X, len(arr))
+	assert.Len(t, soa.Y, len(arr))
+
+	for i, v := range arr {
+		assert.Equal(t, v, soa.At(i))
+		assert.Equal(t, v.X(), soa.X[i])
+		assert.Equal(t, v.Y(), soa.Y[i])
+	}
+	assert.Equal(t, arr, soa.ToArray())
+
+	soa.Set(3, vector2.New(1., 2.))
+	assert.Equal(t, vector2.New(1., 2.), soa.At(3))
+	assert.NotEqual(t, vector2.New(1., 2.), arr[3])
+}
+
+func TestSoA_ElementWise(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	other := vector2.New(1., -2.)
+
+	tests := map[string]struct {
+		soa    func(vector2.Float64SoA) vector2.Float64SoA
+		vector func(vector2.Float64) vector2.Float64
+	}{
+		"add": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.Add(other) },
+			vector: func(v vector2.Float64) vector2.Float64 { return v.Add(other) },
+		},
+		"sub": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.Sub(other) },
+			vector: func(v vector2.Float64) vector2.Float64 { return v.Sub(other) },
+		},
+		"scale": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.Scale(2.5) },
+			vector: func(v vector2.Float64) vector2.Float64 { return v.Scale(2.5) },
+		},
+		"div by constant": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.DivByConstant(3) },
+			vector: func(v vector2.Float64) vector2.Float64 { return v.DivByConstant(3) },
+		},
+		"normalized": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.Normalized() },
+			vector: func(v vector2.Float64) vector2.Float64 { return v.Normalized() },
+		},
+		"modify": {
+			soa:    func(s vector2.Float64SoA) vector2.Float64SoA { return s.Modify(vector2.Float64.Abs) },
+			vector: vector2.Float64.Abs,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			soa := arr.ToSoA()
+			out := tc.soa(soa)
+			assert.Equal(t, len(arr), out.Len())
+			for i, v := range arr {
+				want := tc.vector(v)
+				assert.InDelta(t, want.X(), out.At(i).X(), 0.000001)
+				assert.InDelta(t, want.Y(), out.At(i).Y(), 0.000001)
+			}
+			assert.Equal(t, arr, soa.ToArray())
+		})
+	}
+}
+
+func TestSoA_Inplace(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+
+	arr.AddInplace(vector2.New(1., 2.)).ScaleInplace(0.5)
+	soa.AddInplace(vector2.New(1., 2.)).ScaleInplace(0.5).SubInplace(vector2.New(4., 4.))
+
+	for i, v := range arr {
+		assert.InDelta(t, v.X()-4, soa.X[i], 0.000001)
+		assert.InDelta(t, v.Y()-4, soa.Y[i], 0.000001)
+	}
+}
+
+func TestSoA_Reductions(t *testing.T) {
+	soa := vector2.Float64Array{
+		vector2.New(1., 2.),
+		vector2.New(4., 6.),
+		vector2.New(-2., 4.),
+	}.ToSoA()
+
+	assert.InDelta(t, 5+math.Sqrt(40), soa.Distance(), 0.000001)
+	assert.InDelta(t, math.Sqrt(52), soa.MaxLength(), 0.000001)
+	assert.Equal(t, vector2.New(3., 12.), soa.Sum())
+	assert.Equal(t, vector2.New(1., 4.), soa.Average())
+
+	mean, deviation := soa.StandardDeviation()
+	assert.Equal(t, vector2.New(1., 4.), mean)
+	assert.InDelta(t, math.Sqrt(6), deviation.X(), 0.000001)
+	assert.InDelta(t, math.Sqrt(8./3.), deviation.Y(), 0.000001)
+
+	min, max := soa.Bounds()
+	assert.Equal(t, vector2.New(-2., 2.), min)
+	assert.Equal(t, vector2.New(4., 6.), max)
+}
+
+func TestSoA_ContainsNaN(t *testing.T) {
+	soa := vector2.NewSoA[float64](3)
+	assert.False(t, soa.ContainsNaN())
+
+	soa.Set(1, vector2.New(0., math.NaN()))
+	assert.True(t, soa.ContainsNaN())
+}
+
+func TestSoA_Bounds_PanicsOnZeroPoints(t *testing.T) {
+	assert.PanicsWithError(t, "can not compute bounds from 0 vector elements", func() {
+		vector2.NewSoA[float64](0).Bounds()
+	})
+}
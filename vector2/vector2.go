@@ -292,6 +292,33 @@ func (v Vector[T]) Angle(other Vector[T]) float64 {
 	return math.Acos(vector.Clamp(v.Dot(other)/denominator, -1., 1.))
 }
 
+// SignedAngle returns the angle in radians between this vector and other,
+// which is positive when the rotation from this vector to other is counter
+// clockwise, and negative when clockwise. Opposing vectors return Pi.
+func (v Vector[T]) SignedAngle(other Vector[T]) float64 {
+	vf := v.ToFloat64()
+	of := other.ToFloat64()
+	cross := (vf.x * of.y) - (vf.y * of.x)
+	angle := math.Atan2(cross, vf.Dot(of))
+	if angle == -math.Pi {
+		return math.Pi
+	}
+	return angle
+}
+
+// Rotate rotates the vector counter clockwise about the origin by the angle
+// provided in radians
+func (v Vector[T]) Rotate(angle float64) Vector[T] {
+	cos := math.Cos(angle)
+	sin := math.Sin(angle)
+	x := float64(v.x)
+	y := float64(v.y)
+	return Vector[T]{
+		x: T((x * cos) - (y * sin)),
+		y: T((x * sin) + (y * cos)),
+	}
+}
+
 // Midpoint returns the midpoint between this vector and the vector passed in.
 func (v Vector[T]) Midpoint(o Vector[T]) Vector[T] {
 	return o.Add(v).Scale(0.5)
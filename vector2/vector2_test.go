@@ -466,6 +466,63 @@ func TestAngle(t *testing.T) {
 	}
 }
 
+func TestSignedAngle(t *testing.T) {
+	tests := map[string]struct {
+		a     vector2.Float64
+		b     vector2.Float64
+		angle float64
+	}{
+		"right => up: Pi/2": {
+			a:     vector2.Right[float64](),
+			b:     vector2.Up[float64](),
+			angle: math.Pi / 2,
+		},
+		"up => right: -Pi/2": {
+			a:     vector2.Up[float64](),
+			b:     vector2.Right[float64](),
+			angle: -math.Pi / 2,
+		},
+		"up => down: Pi": {
+			a:     vector2.Up[float64](),
+			b:     vector2.Down[float64](),
+			angle: math.Pi,
+		},
+		"up => up: 0": {
+			a:     vector2.Up[float64](),
+			b:     vector2.Up[float64](),
+			angle: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.angle, tc.a.SignedAngle(tc.b), 0.000001)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := map[string]struct {
+		v     vector2.Float64
+		angle float64
+		want  vector2.Float64
+	}{
+		"right 90":   {v: vector2.Right[float64](), angle: math.Pi / 2, want: vector2.Up[float64]()},
+		"right -90":  {v: vector2.Right[float64](), angle: -math.Pi / 2, want: vector2.Down[float64]()},
+		"(1, 2) 180": {v: vector2.New(1., 2.), angle: math.Pi, want: vector2.New(-1., -2.)},
+		"(2, 0) 45":  {v: vector2.New(2., 0.), angle: math.Pi / 4, want: vector2.New(math.Sqrt2, math.Sqrt2)},
+		"zero":       {v: vector2.Zero[float64](), angle: 1, want: vector2.Zero[float64]()},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.Rotate(tc.angle)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+		})
+	}
+}
+
 func TestMaxMinComponents(t *testing.T) {
 	tests := map[string]struct {
 		a    vector2.Float64
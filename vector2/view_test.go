@@ -0,0 +1,55 @@
+package vector2_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_Flat(t *testing.T) {
+	arr := vector2.Float32Array{
+		vector2.New[float32](1, 2),
+		vector2.New[float32](3, 4),
+	}
+
+	flat := arr.Flat()
+	assert.Equal(t, []float32{1, 2, 3, 4}, flat)
+
+	flat[3] = 40
+	assert.Equal(t, vector2.New[float32](3, 40), arr[1])
+
+	back, err := vector2.ArrayFromFlat(flat)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+
+	_, err = vector2.ArrayFromFlat([]float32{1, 2, 3})
+	assert.EqualError(t, err, "flat data length 3 is not a multiple of 2")
+}
+
+func TestArray_Bytes(t *testing.T) {
+	arr := vector2.Int32Array{
+		vector2.New[int32](1, -2),
+		vector2.New[int32](3, -4),
+	}
+
+	bytes := arr.Bytes()
+	assert.Len(t, bytes, 16)
+
+	back, err := vector2.ArrayFromBytes[int32](bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+
+	_, err = vector2.ArrayFromBytes[int32](bytes[:12])
+	assert.EqualError(t, err, "byte data length 12 is not a multiple of the vector size 8")
+
+	_, err = vector2.ArrayFromBytes[int32](bytes[2:10])
+	assert.EqualError(t, err, "byte data is not aligned to 4 bytes")
+}
+
+func TestVector_HasNoPadding(t *testing.T) {
+	assert.Equal(t, uintptr(2), unsafe.Sizeof(vector2.Int8{}))
+	assert.Equal(t, uintptr(8), unsafe.Sizeof(vector2.Float32{}))
+	assert.Equal(t, uintptr(16), unsafe.Sizeof(vector2.Float64{}))
+}
@@ -0,0 +1,134 @@
+package vector3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAABB(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(2., -1., 4.), vector3.New(-2., 3., 0.))
+
+	assert.Equal(t, vector3.New(-2., -1., 0.), box.Min())
+	assert.Equal(t, vector3.New(2., 3., 4.), box.Max())
+	assert.Equal(t, vector3.New(0., 1., 2.), box.Center())
+	assert.Equal(t, vector3.New(4., 4., 4.), box.Size())
+	assert.InDelta(t, 64, box.Volume(), 0.000001)
+	assert.InDelta(t, 96, box.SurfaceArea(), 0.000001)
+
+	fromCenter := vector3.NewAABBFromCenter(vector3.New(0., 1., 2.), vector3.New(4., -4., 4.))
+	assert.Equal(t, box, fromCenter)
+
+	fromArray := vector3.NewAABB(vector3.Float64Array{
+		vector3.New(-2., 3., 1.),
+		vector3.New(2., -1., 0.),
+		vector3.New(0., 0., 4.),
+	}.Bounds())
+	assert.Equal(t, box, fromArray)
+}
+
+func TestAABB_LongestAxis(t *testing.T) {
+	assert.Equal(t, 0, vector3.NewAABB(vector3.Zero[int](), vector3.New(3, 2, 1)).LongestAxis())
+	assert.Equal(t, 1, vector3.NewAABB(vector3.Zero[int](), vector3.New(1, 3, 2)).LongestAxis())
+	assert.Equal(t, 2, vector3.NewAABB(vector3.Zero[int](), vector3.New(1, 2, 3)).LongestAxis())
+}
+
+func TestAABB_Containment(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(0, 0, 0), vector3.New(10, 10, 10))
+
+	assert.True(t, box.Contains(vector3.New(5, 5, 5)))
+	assert.True(t, box.Contains(vector3.New(10, 0, 10)))
+	assert.False(t, box.Contains(vector3.New(11, 5, 5)))
+	assert.False(t, box.Contains(vector3.New(5, 5, -1)))
+
+	assert.True(t, box.ContainsAABB(vector3.NewAABB(vector3.New(1, 1, 1), vector3.New(9, 9, 9))))
+	assert.False(t, box.ContainsAABB(vector3.NewAABB(vector3.New(1, 1, 1), vector3.New(11, 9, 9))))
+
+	assert.True(t, box.Intersects(vector3.NewAABB(vector3.New(9, 9, 9), vector3.New(20, 20, 20))))
+	assert.True(t, box.Intersects(vector3.NewAABB(vector3.New(10, 10, 10), vector3.New(20, 20, 20))))
+	assert.False(t, box.Intersects(vector3.NewAABB(vector3.New(11, 0, 0), vector3.New(20, 20, 20))))
+}
+
+func TestAABB_Growth(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(0., 0., 0.), vector3.New(1., 1., 1.))
+
+	union := box.Union(vector3.NewAABB(vector3.New(2., -1., 0.), vector3.New(3., 0., 0.5)))
+	assert.Equal(t, vector3.New(0., -1., 0.), union.Min())
+	assert.Equal(t, vector3.New(3., 1., 1.), union.Max())
+
+	include := box.Include(vector3.New(-1., 0.5, 4.))
+	assert.Equal(t, vector3.New(-1., 0., 0.), include.Min())
+	assert.Equal(t, vector3.New(1., 1., 4.), include.Max())
+
+	expand := box.Expand(1)
+	assert.Equal(t, vector3.New(-1., -1., -1.), expand.Min())
+	assert.Equal(t, vector3.New(2., 2., 2.), expand.Max())
+}
+
+func TestAABB_Distance(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(0., 0., 0.), vector3.New(1., 1., 1.))
+
+	assert.Equal(t, vector3.New(1., 0.5, 0.), box.ClosestPoint(vector3.New(3., 0.5, -2.)))
+	assert.InDelta(t, 8, box.DistanceSquared(vector3.New(3., 0.5, -2.)), 0.000001)
+	assert.InDelta(t, 0, box.DistanceSquared(vector3.New(0.5, 0.5, 0.5)), 0.000001)
+
+	assert.True(t, box.IntersectsSphere(vector3.New(3., 0.5, 0.5), 2))
+	assert.False(t, box.IntersectsSphere(vector3.New(3., 0.5, 0.5), 1.9))
+}
+
+func TestAABB_IntersectRay(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(-1., -1., -1.), vector3.New(1., 1., 1.))
+
+	tests := map[string]struct {
+		origin    vector3.Float64
+		direction vector3.Float64
+		hit       bool
+		tMin      float64
+		tMax      float64
+	}{
+		"straight on": {
+			origin: vector3.New(-5., 0., 0.), direction: vector3.Right[float64](),
+			hit: true, tMin: 4, tMax: 6,
+		},
+		"from inside": {
+			origin: vector3.Zero[float64](), direction: vector3.Up[float64](),
+			hit: true, tMin: -1, tMax: 1,
+		},
+		"pointing away": {
+			origin: vector3.New(-5., 0., 0.), direction: vector3.Left[float64](),
+			hit: false,
+		},
+		"parallel outside": {
+			origin: vector3.New(-5., 2., 0.), direction: vector3.Right[float64](),
+			hit: false,
+		},
+		"diagonal": {
+			origin: vector3.New(-2., -2., -2.), direction: vector3.One[float64](),
+			hit: true, tMin: 1, tMax: 3,
+		},
+		"miss": {
+			origin: vector3.New(-5., 0., 0.), direction: vector3.New(1., 1., 0.),
+			hit: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tMin, tMax, hit := box.IntersectRay(tc.origin, tc.direction)
+			assert.Equal(t, tc.hit, hit)
+			if tc.hit {
+				assert.InDelta(t, tc.tMin, tMin, 0.000001)
+				assert.InDelta(t, tc.tMax, tMax, 0.000001)
+			}
+		})
+	}
+}
+
+func TestAABB_ToFloat64(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(1, 2, 3), vector3.New(4, 5, 6)).ToFloat64()
+	assert.Equal(t, vector3.New(1., 2., 3.), box.Min())
+	assert.Equal(t, vector3.New(4., 5., 6.), box.Max())
+	assert.False(t, math.IsNaN(box.Volume()))
+}
@@ -0,0 +1,152 @@
+package vector3
+
+import (
+	"fmt"
+	"math"
+)
+
+// UpAxis determines which cartesian axis spherical and cylindrical
+// coordinates treat as "up". Polar angles and cylinder heights are measured
+// along the up axis, while azimuths are measured around it.
+type UpAxis int
+
+const (
+	// ZUp measures height along +Z, and azimuth from +X towards +Y. This is
+	// the convention used in most mathematics, physics, GIS and robotics
+	// literature.
+	ZUp UpAxis = iota
+
+	// YUp measures height along +Y, and azimuth from +Z towards +X. This
+	// matches the Up and Forward vectors of this package, and most game
+	// engines.
+	YUp
+)
+
+// SphericalConvention determines the order and meaning of the angles that
+// make up a spherical coordinate. Regardless of convention, the radius is
+// always the first component, all angles are in radians, and azimuths fall
+// within (-Pi, Pi].
+type SphericalConvention int
+
+const (
+	// PhysicsSpherical packs coordinates as (radius, polar, azimuth), where
+	// the polar angle (θ) is measured from the up axis and falls within
+	// [0, Pi]. This follows ISO 80000-2.
+	PhysicsSpherical SphericalConvention = iota
+
+	// MathSpherical packs coordinates as (radius, azimuth, polar), where the
+	// azimuth is named θ and the polar angle is named φ.
+	MathSpherical
+
+	// ElevationSpherical packs coordinates as (range, azimuth, elevation),
+	// where the elevation is measured up from the horizontal plane and falls
+	// within [-Pi/2, Pi/2]. This is common for radar and lidar sensors.
+	ElevationSpherical
+)
+
+// toUpSpace reorders the components so the up axis is last, and the azimuth
+// is measured from the first component towards the second
+func toUpSpace(v Float64, up UpAxis) Float64 {
+	switch up {
+	case ZUp:
+		return v
+	case YUp:
+		return Float64{x: v.z, y: v.x, z: v.y}
+	}
+	panic(fmt.Errorf("unknown up axis: %d", up))
+}
+
+// fromUpSpace reverses toUpSpace
+func fromUpSpace(v Float64, up UpAxis) Float64 {
+	switch up {
+	case ZUp:
+		return v
+	case YUp:
+		return Float64{x: v.y, y: v.z, z: v.x}
+	}
+	panic(fmt.Errorf("unknown up axis: %d", up))
+}
+
+// azimuthOf returns the angle of (x, y) counter clockwise from the positive X
+// axis, within (-Pi, Pi]
+func azimuthOf(y, x float64) float64 {
+	angle := math.Atan2(y, x)
+	if angle == -math.Pi {
+		return math.Pi
+	}
+	return angle
+}
+
+// ToSpherical converts the cartesian vector into spherical coordinates, packed
+// into a vector in the order defined by the convention provided.
+func (v Vector[T]) ToSpherical(convention SphericalConvention, up UpAxis) Float64 {
+	c := toUpSpace(v.ToFloat64(), up)
+
+	radius := c.Length()
+	azimuth := azimuthOf(c.y, c.x)
+	polar := 0.
+	if radius > 0 {
+		polar = math.Acos(c.z / radius)
+	}
+
+	switch convention {
+	case PhysicsSpherical:
+		return Float64{x: radius, y: polar, z: azimuth}
+
+	case MathSpherical:
+		return Float64{x: radius, y: azimuth, z: polar}
+
+	case ElevationSpherical:
+		return Float64{x: radius, y: azimuth, z: (math.Pi / 2) - polar}
+	}
+
+	panic(fmt.Errorf("unknown spherical convention: %d", convention))
+}
+
+// FromSpherical converts spherical coordinates, packed in the order defined by
+// the convention provided, into a cartesian vector.
+func FromSpherical(coordinates Float64, convention SphericalConvention, up UpAxis) Float64 {
+	var radius, polar, azimuth float64
+	switch convention {
+	case PhysicsSpherical:
+		radius, polar, azimuth = coordinates.x, coordinates.y, coordinates.z
+
+	case MathSpherical:
+		radius, azimuth, polar = coordinates.x, coordinates.y, coordinates.z
+
+	case ElevationSpherical:
+		radius, azimuth, polar = coordinates.x, coordinates.y, (math.Pi/2)-coordinates.z
+
+	default:
+		panic(fmt.Errorf("unknown spherical convention: %d", convention))
+	}
+
+	sinPolar := math.Sin(polar)
+	return fromUpSpace(Float64{
+		x: radius * sinPolar * math.Cos(azimuth),
+		y: radius * sinPolar * math.Sin(azimuth),
+		z: radius * math.Cos(polar),
+	}, up)
+}
+
+// ToCylindrical converts the cartesian vector into cylindrical coordinates,
+// packed as (radius, azimuth, height). The radius is the distance from the up
+// axis and the height is the distance along it.
+func (v Vector[T]) ToCylindrical(up UpAxis) Float64 {
+	c := toUpSpace(v.ToFloat64(), up)
+	return Float64{
+		x: math.Hypot(c.x, c.y),
+		y: azimuthOf(c.y, c.x),
+		z: c.z,
+	}
+}
+
+// FromCylindrical converts cylindrical coordinates packed as
+// (radius, azimuth, height) into a cartesian vector.
+func FromCylindrical(coordinates Float64, up UpAxis) Float64 {
+	return fromUpSpace(Float64{
+		x: coordinates.x * math.Cos(coordinates.y),
+		y: coordinates.x * math.Sin(coordinates.y),
+		z: coordinates.z,
+	}, up)
+}
@@ -0,0 +1,154 @@
+package vector3_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSpherical(t *testing.T) {
+	tests := map[string]struct {
+		v          vector3.Float64
+		convention vector3.SphericalConvention
+		up         vector3.UpAxis
+		want       vector3.Float64
+	}{
+		"physics z-up: +z": {
+			v: vector3.New(0., 0., 2.), convention: vector3.PhysicsSpherical, up: vector3.ZUp,
+			want: vector3.New(2., 0., 0.),
+		},
+		"physics z-up: +y": {
+			v: vector3.New(0., 3., 0.), convention: vector3.PhysicsSpherical, up: vector3.ZUp,
+			want: vector3.New(3., math.Pi/2, math.Pi/2),
+		},
+		"math z-up: +y": {
+			v: vector3.New(0., 3., 0.), convention: vector3.MathSpherical, up: vector3.ZUp,
+			want: vector3.New(3., math.Pi/2, math.Pi/2),
+		},
+		"math z-up: -x": {
+			v: vector3.New(-1., 0., 0.), convention: vector3.MathSpherical, up: vector3.ZUp,
+			want: vector3.New(1., math.Pi, math.Pi/2),
+		},
+		"math z-up: -x, -0 y": {
+			v: vector3.New(-1., math.Copysign(0, -1), 0.), convention: vector3.MathSpherical, up: vector3.ZUp,
+			want: vector3.New(1., math.Pi, math.Pi/2),
+		},
+		"physics z-up: diagonal": {
+			v: vector3.New(1., 1., math.Sqrt2), convention: vector3.PhysicsSpherical, up: vector3.ZUp,
+			want: vector3.New(2., math.Pi/4, math.Pi/4),
+		},
+		"math z-up: diagonal": {
+			v: vector3.New(1., 1., math.Sqrt2), convention: vector3.MathSpherical, up: vector3.ZUp,
+			want: vector3.New(2., math.Pi/4, math.Pi/4),
+		},
+		"physics y-up: up": {
+			v: vector3.Up[float64](), convention: vector3.PhysicsSpherical, up: vector3.YUp,
+			want: vector3.New(1., 0., 0.),
+		},
+		"physics y-up: forward": {
+			v: vector3.Forward[float64](), convention: vector3.PhysicsSpherical, up: vector3.YUp,
+			want: vector3.New(1., math.Pi/2, 0.),
+		},
+		"physics y-up: right": {
+			v: vector3.Right[float64](), convention: vector3.PhysicsSpherical, up: vector3.YUp,
+			want: vector3.New(1., math.Pi/2, math.Pi/2),
+		},
+		"elevation z-up: level": {
+			v: vector3.New(0., 5., 0.), convention: vector3.ElevationSpherical, up: vector3.ZUp,
+			want: vector3.New(5., math.Pi/2, 0.),
+		},
+		"elevation z-up: below": {
+			v: vector3.New(1., 0., -1.), convention: vector3.ElevationSpherical, up: vector3.ZUp,
+			want: vector3.New(math.Sqrt2, 0., -math.Pi/4),
+		},
+		"elevation y-up: overhead": {
+			v: vector3.New(0., 7., 0.), convention: vector3.ElevationSpherical, up: vector3.YUp,
+			want: vector3.New(7., 0., math.Pi/2),
+		},
+		"origin": {
+			v: vector3.Zero[float64](), convention: vector3.PhysicsSpherical, up: vector3.ZUp,
+			want: vector3.Zero[float64](),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.ToSpherical(tc.convention, tc.up)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+			assert.InDelta(t, tc.want.Z(), got.Z(), 0.000001)
+		})
+	}
+}
+
+func TestSphericalRoundTrip(t *testing.T) {
+	conventions := []vector3.SphericalConvention{
+		vector3.PhysicsSpherical,
+		vector3.MathSpherical,
+		vector3.ElevationSpherical,
+	}
+	ups := []vector3.UpAxis{vector3.ZUp, vector3.YUp}
+
+	r := rand.New(rand.NewSource(42))
+	for _, convention := range conventions {
+		for _, up := range ups {
+			t.Run(fmt.Sprintf("convention %d up %d", convention, up), func(t *testing.T) {
+				for i := 0; i < 100; i++ {
+					v := vector3.RandRange(r, -10., 10.)
+					back := vector3.FromSpherical(v.ToSpherical(convention, up), convention, up)
+					assert.InDelta(t, v.X(), back.X(), 0.000001)
+					assert.InDelta(t, v.Y(), back.Y(), 0.000001)
+					assert.InDelta(t, v.Z(), back.Z(), 0.000001)
+				}
+			})
+		}
+	}
+}
+
+func TestToCylindrical(t *testing.T) {
+	tests := map[string]struct {
+		v    vector3.Float64
+		up   vector3.UpAxis
+		want vector3.Float64
+	}{
+		"z-up": {v: vector3.New(0., 2., 5.), up: vector3.ZUp, want: vector3.New(2., math.Pi/2, 5.)},
+		"y-up": {v: vector3.New(3., 5., 0.), up: vector3.YUp, want: vector3.New(3., math.Pi/2, 5.)},
+		"axis": {v: vector3.New(0., 0., -4.), up: vector3.ZUp, want: vector3.New(0., 0., -4.)},
+		"-0 y": {v: vector3.New(-2., math.Copysign(0, -1), 1.), up: vector3.ZUp, want: vector3.New(2., math.Pi, 1.)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.ToCylindrical(tc.up)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+			assert.InDelta(t, tc.want.Z(), got.Z(), 0.000001)
+		})
+	}
+}
+
+func TestCylindricalRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, up := range []vector3.UpAxis{vector3.ZUp, vector3.YUp} {
+		for i := 0; i < 100; i++ {
+			v := vector3.RandRange(r, -10., 10.)
+			back := vector3.FromCylindrical(v.ToCylindrical(up), up)
+			assert.InDelta(t, v.X(), back.X(), 0.000001)
+			assert.InDelta(t, v.Y(), back.Y(), 0.000001)
+			assert.InDelta(t, v.Z(), back.Z(), 0.000001)
+		}
+	}
+}
+
+func TestSpherical_PanicsOnUnknownConvention(t *testing.T) {
+	assert.PanicsWithError(t, "unknown spherical convention: 7", func() {
+		vector3.One[float64]().ToSpherical(7, vector3.ZUp)
+	})
+	assert.PanicsWithError(t, "unknown up axis: 3", func() {
+		vector3.FromCylindrical(vector3.One[float64](), 3)
+	})
+}
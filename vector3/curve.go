@@ -0,0 +1,249 @@
+package vector3
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// CurveBits is the number of bits per axis supported by the Morton and
+// Hilbert encodings, allowing all three axes to be packed into a uint64
+const CurveBits = 21
+
+// Curve is a space filling curve, which maps 3D cells to a 1D ordering that
+// keeps cells near each other in space near each other in the ordering
+type Curve int
+
+const (
+	// MortonCurve is the Z-order curve, which interleaves the bits of each
+	// axis. It is cheap to compute, but makes large jumps between some
+	// neighbouring cells.
+	MortonCurve Curve = iota
+
+	// HilbertCurve only ever steps between adjacent cells, giving better
+	// locality than the Morton curve at a higher cost to compute
+	HilbertCurve
+)
+
+func checkCurveComponent(c int) uint64 {
+	if c < 0 || uint64(c) >= 1<<CurveBits {
+		panic(fmt.Errorf("component out of range for a %d bit curve: %d", CurveBits, c))
+	}
+	return uint64(c)
+}
+
+// spreadBits inserts two zeros between each of the lower 21 bits of x
+func spreadBits(x uint64) uint64 {
+	x &= 0x1fffff
+	x = (x | x<<32) & 0x1f00000000ffff
+	x = (x | x<<16) & 0x1f0000ff0000ff
+	x = (x | x<<8) & 0x100f00f00f00f00f
+	x = (x | x<<4) & 0x10c30c30c30c30c3
+	x = (x | x<<2) & 0x1249249249249249
+	return x
+}
+
+// compactBits reverses spreadBits
+func compactBits(x uint64) uint64 {
+	x &= 0x1249249249249249
+	x = (x ^ x>>2) & 0x10c30c30c30c30c3
+	x = (x ^ x>>4) & 0x100f00f00f00f00f
+	x = (x ^ x>>8) & 0x1f0000ff0000ff
+	x = (x ^ x>>16) & 0x1f00000000ffff
+	x = (x ^ x>>32) & 0x1fffff
+	return x
+}
+
+// MortonEncode interleaves the bits of each component into a single Morton
+// code, with X occupying the least significant bit. Components must fall
+// within [0, 2^CurveBits).
+func MortonEncode(v Vector[int]) uint64 {
+	return spreadBits(checkCurveComponent(v.x)) |
+		spreadBits(checkCurveComponent(v.y))<<1 |
+		spreadBits(checkCurveComponent(v.z))<<2
+}
+
+// MortonDecode converts a Morton code back into the vector it encodes
+func MortonDecode(code uint64) Vector[int] {
+	return Vector[int]{
+		x: int(compactBits(code)),
+		y: int(compactBits(code >> 1)),
+		z: int(compactBits(code >> 2)),
+	}
+}
+
+// HilbertEncode computes the distance along a Hilbert curve that fills a
+// cube of 2^CurveBits cells along each side. Components must fall within
+// [0, 2^CurveBits).
+//
+// Implemented using John Skilling's transpose, from "Programming the Hilbert
+// curve" (2004).
+func HilbertEncode(v Vector[int]) uint64 {
+	x := [3]uint64{
+		checkCurveComponent(v.x),
+		checkCurveComponent(v.y),
+		checkCurveComponent(v.z),
+	}
+
+	// Inverse undo
+	for q := uint64(1) << (CurveBits - 1); q > 1; q >>= 1 {
+		p := q - 1
+		for i := range x {
+			if x[i]&q != 0 {
+				x[0] ^= p
+			} else {
+				t := (x[0] ^ x[i]) & p
+				x[0] ^= t
+				x[i] ^= t
+			}
+		}
+	}
+
+	// Gray encode
+	for i := 1; i < len(x); i++ {
+		x[i] ^= x[i-1]
+	}
+	t := uint64(0)
+	for q := uint64(1) << (CurveBits - 1); q > 1; q >>= 1 {
+		if x[len(x)-1]&q != 0 {
+			t ^= q - 1
+		}
+	}
+	for i := range x {
+		x[i] ^= t
+	}
+
+	// The first axis of the transpose holds the most significant bit
+	return spreadBits(x[2]) | spreadBits(x[1])<<1 | spreadBits(x[0])<<2
+}
+
+// HilbertDecode converts a distance along the Hilbert curve back into the
+// cell it refers to
+func HilbertDecode(code uint64) Vector[int] {
+	x := [3]uint64{
+		compactBits(code >> 2),
+		compactBits(code >> 1),
+		compactBits(code),
+	}
+
+	// Gray decode
+	t := x[len(x)-1] >> 1
+	for i := len(x) - 1; i > 0; i-- {
+		x[i] ^= x[i-1]
+	}
+	x[0] ^= t
+
+	// Undo excess work
+	for q := uint64(2); q < 1<<CurveBits; q <<= 1 {
+		p := q - 1
+		for i := len(x) - 1; i >= 0; i-- {
+			if x[i]&q != 0 {
+				x[0] ^= p
+			} else {
+				t := (x[0] ^ x[i]) & p
+				x[0] ^= t
+				x[i] ^= t
+			}
+		}
+	}
+
+	return Vector[int]{x: int(x[0]), y: int(x[1]), z: int(x[2])}
+}
+
+// Encode computes the position of the cell along the curve
+func (c Curve) Encode(v Vector[int]) uint64 {
+	switch c {
+	case MortonCurve:
+		return MortonEncode(v)
+	case HilbertCurve:
+		return HilbertEncode(v)
+	}
+	panic(fmt.Errorf("unknown curve: %d", c))
+}
+
+// Decode computes the cell found at the position along the curve
+func (c Curve) Decode(code uint64) Vector[int] {
+	switch c {
+	case MortonCurve:
+		return MortonDecode(code)
+	case HilbertCurve:
+		return HilbertDecode(code)
+	}
+	panic(fmt.Errorf("unknown curve: %d", c))
+}
+
+func checkQuantizationBits(bits int) {
+	if bits < 1 || bits > CurveBits {
+		panic(fmt.Errorf("invalid quantization bits: %d", bits))
+	}
+}
+
+// Quantize divides the bounding box into a grid of 2^bits cells along each
+// axis, and returns the cell the vector falls within. Vectors outside of the
+// bounding box are clamped to the nearest cell, and NaN components fall within
+// the first cell. bits must fall within
+// [1, CurveBits].
+func (b AABB[T]) Quantize(v Vector[T], bits int) Vector[int] {
+	checkQuantizationBits(bits)
+	cells := 1 << bits
+	lo := b.min.ToFloat64()
+	size := b.Size().ToFloat64()
+	p := v.ToFloat64()
+
+	quantize := func(v, lo, size float64) int {
+		cell := (v - lo) / size * float64(cells)
+		if size <= 0 || math.IsNaN(cell) {
+			return 0
+		}
+
+		// Clamp before converting, as out of range floats don't convert to
+		// a meaningful int
+		return int(math.Max(0, math.Min(float64(cells-1), cell)))
+	}
+
+	return Vector[int]{
+		x: quantize(p.x, lo.x, size.x),
+		y: quantize(p.y, lo.y, size.y),
+		z: quantize(p.z, lo.z, size.z),
+	}
+}
+
+// Dequantize returns the center of the cell produced by Quantize
+func (b AABB[T]) Dequantize(cell Vector[int], bits int) Vector[float64] {
+	checkQuantizationBits(bits)
+	return cell.ToFloat64().
+		Add(Fill(0.5)).
+		DivByConstant(float64(int(1) << bits)).
+		MultByVector(b.Size().ToFloat64()).
+		Add(b.min.ToFloat64())
+}
+
+// SortByCurve sorts the array in place by the order each vector is visited
+// along the curve, after quantizing them into the array's bounds. The
+// permutation applied is returned so associated data can be reordered to
+// match, where the i'th element of the sorted array was previously found at
+// permutation[i].
+func (v3a Array[T]) SortByCurve(curve Curve) []int {
+	permutation := make([]int, len(v3a))
+	if len(v3a) == 0 {
+		return permutation
+	}
+
+	bounds := NewAABB(v3a.Bounds())
+	codes := make([]uint64, len(v3a))
+	for i, v := range v3a {
+		permutation[i] = i
+		codes[i] = curve.Encode(bounds.Quantize(v, CurveBits))
+	}
+
+	sort.SliceStable(permutation, func(i, j int) bool {
+		return codes[permutation[i]] < codes[permutation[j]]
+	})
+
+	original := make(Array[T], len(v3a))
+	copy(original, v3a)
+	for i, p := range permutation {
+		v3a[i] = original[p]
+	}
+	return permutation
+}
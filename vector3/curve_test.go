@@ -0,0 +1,146 @@
+package vector3_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMortonEncode(t *testing.T) {
+	tests := map[string]struct {
+		v    vector3.Int
+		code uint64
+	}{
+		"origin": {v: vector3.New(0, 0, 0), code: 0},
+		"x":      {v: vector3.New(1, 0, 0), code: 1},
+		"y":      {v: vector3.New(0, 1, 0), code: 2},
+		"z":      {v: vector3.New(0, 0, 1), code: 4},
+		"xyz":    {v: vector3.New(3, 3, 3), code: 63},
+		"max":    {v: vector3.Fill(1<<vector3.CurveBits - 1), code: 1<<63 - 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.code, vector3.MortonEncode(tc.v))
+			assert.Equal(t, tc.v, vector3.MortonDecode(tc.code))
+		})
+	}
+}
+
+func TestCurve_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, curve := range []vector3.Curve{vector3.MortonCurve, vector3.HilbertCurve} {
+		for i := 0; i < 1000; i++ {
+			v := vector3.New(
+				r.Intn(1<<vector3.CurveBits),
+				r.Intn(1<<vector3.CurveBits),
+				r.Intn(1<<vector3.CurveBits),
+			)
+			assert.Equal(t, v, curve.Decode(curve.Encode(v)))
+		}
+	}
+}
+
+func TestHilbert_ConsecutiveCellsAreAdjacent(t *testing.T) {
+	previous := vector3.HilbertDecode(0)
+	assert.Equal(t, vector3.Zero[int](), previous)
+
+	seen := make(map[vector3.Int]bool)
+	seen[previous] = true
+	for code := uint64(1); code < 1<<12; code++ {
+		current := vector3.HilbertDecode(code)
+		diff := current.Sub(previous).Abs()
+		assert.Equal(t, 1, diff.X()+diff.Y()+diff.Z(), "code %d", code)
+		assert.False(t, seen[current])
+		seen[current] = true
+		previous = current
+	}
+}
+
+func TestCurve_Panics(t *testing.T) {
+	assert.PanicsWithError(t, "component out of range for a 21 bit curve: -1", func() {
+		vector3.MortonEncode(vector3.New(0, -1, 0))
+	})
+	assert.PanicsWithError(t, "component out of range for a 21 bit curve: 2097152", func() {
+		vector3.HilbertEncode(vector3.New(0, 0, 1<<21))
+	})
+	assert.PanicsWithError(t, "unknown curve: 5", func() {
+		vector3.Curve(5).Encode(vector3.Zero[int]())
+	})
+	assert.PanicsWithError(t, "invalid quantization bits: 22", func() {
+		vector3.NewAABB(vector3.Zero[float64](), vector3.One[float64]()).Quantize(vector3.Zero[float64](), 22)
+	})
+}
+
+func TestAABB_Quantize(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(-1., 0., 10.), vector3.New(1., 4., 10.))
+
+	tests := map[string]struct {
+		v    vector3.Float64
+		bits int
+		want vector3.Int
+	}{
+		"min corner":    {v: vector3.New(-1., 0., 10.), bits: 2, want: vector3.New(0, 0, 0)},
+		"max corner":    {v: vector3.New(1., 4., 10.), bits: 2, want: vector3.New(3, 3, 0)},
+		"middle":        {v: vector3.New(0., 2., 10.), bits: 2, want: vector3.New(2, 2, 0)},
+		"just below":    {v: vector3.New(-0.01, 1.99, 10.), bits: 2, want: vector3.New(1, 1, 0)},
+		"clamped":       {v: vector3.New(-5., 50., 3.), bits: 4, want: vector3.New(0, 15, 0)},
+		"one bit":       {v: vector3.New(0.5, 1., 10.), bits: 1, want: vector3.New(1, 0, 0)},
+		"full range":    {v: vector3.New(1., 4., 10.), bits: 21, want: vector3.New(1<<21-1, 1<<21-1, 0)},
+		"not inclusive": {v: vector3.New(0.999, 3.999, 10.), bits: 1, want: vector3.New(1, 1, 0)},
+		"huge":          {v: vector3.New(1e300, -1e300, 10.), bits: 21, want: vector3.New(1<<21-1, 0, 0)},
+		"infinite":      {v: vector3.New(math.Inf(1), math.Inf(-1), 10.), bits: 4, want: vector3.New(15, 0, 0)},
+		"nan":           {v: vector3.New(math.NaN(), 2., 10.), bits: 2, want: vector3.New(0, 2, 0)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, box.Quantize(tc.v, tc.bits))
+		})
+	}
+}
+
+func TestAABB_Dequantize(t *testing.T) {
+	box := vector3.NewAABB(vector3.New(-1., 0., 2.), vector3.New(1., 4., 6.))
+	assert.Equal(t, vector3.New(-0.75, 0.5, 2.5), box.Dequantize(vector3.New(0, 0, 0), 2))
+	assert.Equal(t, vector3.New(0.75, 3.5, 5.5), box.Dequantize(vector3.New(3, 3, 3), 2))
+
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		v := vector3.RandRange(r, -1., 1.).MultByVector(vector3.New(1., 2., 2.)).Add(vector3.New(0., 2., 4.))
+		back := box.Dequantize(box.Quantize(v, 10), 10)
+		assert.InDelta(t, v.X(), back.X(), 2./1024)
+		assert.InDelta(t, v.Y(), back.Y(), 4./1024)
+		assert.InDelta(t, v.Z(), back.Z(), 4./1024)
+	}
+}
+
+func TestArray_SortByCurve(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, curve := range []vector3.Curve{vector3.MortonCurve, vector3.HilbertCurve} {
+		original := make(vector3.Float64Array, 500)
+		for i := range original {
+			original[i] = vector3.RandRange(r, -10., 10.)
+		}
+		bounds := vector3.NewAABB(original.Bounds())
+
+		sorted := make(vector3.Float64Array, len(original))
+		copy(sorted, original)
+		permutation := sorted.SortByCurve(curve)
+
+		assert.Len(t, permutation, len(original))
+		for i, p := range permutation {
+			assert.Equal(t, original[p], sorted[i])
+		}
+		for i := 1; i < len(sorted); i++ {
+			previous := curve.Encode(bounds.Quantize(sorted[i-1], vector3.CurveBits))
+			current := curve.Encode(bounds.Quantize(sorted[i], vector3.CurveBits))
+			assert.LessOrEqual(t, previous, current)
+		}
+	}
+
+	assert.Len(t, vector3.Float64Array{}.SortByCurve(vector3.HilbertCurve), 0)
+}
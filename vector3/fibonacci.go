@@ -0,0 +1,114 @@
+package vector3
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// goldenRatio is (1 + √5) / 2
+var goldenRatio = (1 + math.Sqrt(5)) / 2
+
+// validateFibonacciCount panics if n points can't be indexed with a uint32
+func validateFibonacciCount(n int) {
+	if n <= 0 || uint64(n) > math.MaxUint32+1 {
+		panic(fmt.Errorf("invalid spherical fibonacci point count: %d", n))
+	}
+}
+
+// fract returns the fractional part of a*b
+func fract(a, b float64) float64 {
+	ab := a * b
+	return ab - math.Floor(ab)
+}
+
+// sphericalFibonacci returns point i of n without validating either
+func sphericalFibonacci(i, n float64) Float64 {
+	phi := 2 * math.Pi * fract(i, goldenRatio-1)
+	cosTheta := 1 - (2*i+1)/n
+	sinTheta := math.Sqrt(max(1-cosTheta*cosTheta, 0))
+	return New(math.Cos(phi)*sinTheta, math.Sin(phi)*sinTheta, cosTheta)
+}
+
+// SphericalFibonacci returns point i of n unit vectors distributed evenly
+// over the sphere along a Fibonacci spiral, running from +Z to -Z. It panics
+// if i is not within [0, n).
+func SphericalFibonacci(i uint32, n int) Float64 {
+	validateFibonacciCount(n)
+	if uint64(i) >= uint64(n) {
+		panic(fmt.Errorf("spherical fibonacci index %d out of range for %d points", i, n))
+	}
+	return sphericalFibonacci(float64(i), float64(n))
+}
+
+// EncodeSphericalFibonacci compresses the direction of the vector into the
+// index of the closest of n points produced by SphericalFibonacci, using the
+// inverse mapping from "Spherical Fibonacci Mapping" (Keinert et al. 2015).
+// The vector does not need to be normalized. The mean angular error is
+// roughly 1.35 / √n radians, and the maximum under 3 / √n radians.
+func EncodeSphericalFibonacci[T vector.Number](v Vector[T], n int) uint32 {
+	validateFibonacciCount(n)
+	p := v.ToFloat64().Normalized()
+	if p.ContainsNaN() {
+		p = Forward[float64]()
+	}
+
+	fn := float64(n)
+	phi := min(math.Atan2(p.y, p.x), math.Pi)
+	cosTheta := p.z
+
+	// The lattice around the point is spanned by two consecutive Fibonacci
+	// numbers, chosen by how tightly the spiral is wound at this latitude
+	k := max(2, math.Floor(math.Log(fn*math.Pi*math.Sqrt(5)*(1-cosTheta*cosTheta))/math.Log(goldenRatio*goldenRatio)))
+	fk := math.Pow(goldenRatio, k) / math.Sqrt(5)
+	f0, f1 := math.Round(fk), math.Round(fk*goldenRatio)
+
+	b00 := 2*math.Pi*fract(f0+1, goldenRatio-1) - 2*math.Pi*(goldenRatio-1)
+	b01 := 2*math.Pi*fract(f1+1, goldenRatio-1) - 2*math.Pi*(goldenRatio-1)
+	b10 := -2 * f0 / fn
+	b11 := -2 * f1 / fn
+
+	det := b00*b11 - b01*b10
+	y := cosTheta - (1 - 1/fn)
+	c0 := math.Floor((b11*phi - b01*y) / det)
+	c1 := math.Floor((-b10*phi + b00*y) / det)
+
+	best := 0.
+	bestDist := math.Inf(1)
+	for s := 0; s < 4; s++ {
+		u, w := float64(s%2)+c0, float64(s/2)+c1
+		z := b10*u + b11*w + (1 - 1/fn)
+
+		// Reflect points that land past the poles back onto the sphere
+		z = vector.Clamp(z, -1, 1)*2 - z
+		i := vector.Clamp(math.Floor(fn*0.5-z*fn*0.5), 0, fn-1)
+
+		if dist := sphericalFibonacci(i, fn).Sub(p).LengthSquared(); dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+	return uint32(best)
+}
+
+// EncodeSphericalFibonacci compresses the direction of every vector in the
+// array with EncodeSphericalFibonacci
+func (v3a Array[T]) EncodeSphericalFibonacci(n int) []uint32 {
+	validateFibonacciCount(n)
+	out := make([]uint32, len(v3a))
+	for i, v := range v3a {
+		out[i] = EncodeSphericalFibonacci(v, n)
+	}
+	return out
+}
+
+// DecodeSphericalFibonacciArray converts indices produced by
+// EncodeSphericalFibonacci with the same number of points back into unit
+// vectors
+func DecodeSphericalFibonacciArray(indices []uint32, n int) Float64Array {
+	out := make(Float64Array, len(indices))
+	for i, index := range indices {
+		out[i] = SphericalFibonacci(index, n)
+	}
+	return out
+}
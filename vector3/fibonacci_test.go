@@ -0,0 +1,97 @@
+package vector3_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSphericalFibonacci_Points(t *testing.T) {
+	n := 1000
+	sum := vector3.Zero[float64]()
+	for i := 0; i < n; i++ {
+		p := vector3.SphericalFibonacci(uint32(i), n)
+		assert.InDelta(t, 1, p.Length(), 1e-12)
+		sum = sum.Add(p)
+	}
+
+	// Spirals from +Z to -Z, evenly enough to nearly cancel out
+	assert.Greater(t, vector3.SphericalFibonacci(0, n).Z(), 0.99)
+	assert.Less(t, vector3.SphericalFibonacci(uint32(n-1), n).Z(), -0.99)
+	assert.Less(t, sum.Length()/float64(n), 0.01)
+}
+
+func TestEncodeSphericalFibonacci_MatchesClosestPoint(t *testing.T) {
+	directions := randomDirections(rand.New(rand.NewSource(42)), 300)
+	for _, n := range []int{1, 2, 10, 500, 4096} {
+		points := make(vector3.Float64Array, n)
+		for i := range points {
+			points[i] = vector3.SphericalFibonacci(uint32(i), n)
+		}
+
+		for _, v := range directions {
+			closest, closestDist := 0, math.Inf(1)
+			for i, p := range points {
+				if d := p.Distance(v); d < closestDist {
+					closest, closestDist = i, d
+				}
+			}
+
+			encoded := vector3.EncodeSphericalFibonacci(v, n)
+			if encoded != uint32(closest) {
+				// Ties between equally distant points may go either way
+				assert.InDelta(t, closestDist, points[encoded].Distance(v), 1e-12, "n: %d v: %v", n, v)
+			}
+		}
+	}
+}
+
+func TestEncodeSphericalFibonacci_ErrorBounds(t *testing.T) {
+	directions := randomDirections(rand.New(rand.NewSource(42)), 20_000)
+	for _, n := range []int{1 << 8, 1 << 16, 1 << 24, 1 << 32} {
+		worst, total := 0., 0.
+		for _, v := range directions {
+			back := vector3.SphericalFibonacci(vector3.EncodeSphericalFibonacci(v, n), n)
+			err := v.Angle(back)
+			worst = max(worst, err)
+			total += err
+		}
+
+		scale := math.Sqrt(float64(n))
+		assert.Less(t, worst*scale, 3., "n: %d", n)
+		assert.Less(t, total/float64(len(directions))*scale, 1.4, "n: %d", n)
+	}
+}
+
+func TestSphericalFibonacci_Array(t *testing.T) {
+	n := 1 << 20
+	directions := randomDirections(rand.New(rand.NewSource(42)), 1000)
+	scaled := directions.Scale(3)
+
+	indices := scaled.EncodeSphericalFibonacci(n)
+	assert.Len(t, indices, len(directions))
+
+	decoded := vector3.DecodeSphericalFibonacciArray(indices, n)
+	for i, v := range directions {
+		assert.Equal(t, vector3.EncodeSphericalFibonacci(v, n), indices[i])
+		assert.Less(t, v.Angle(decoded[i]), 3/math.Sqrt(float64(n)))
+	}
+}
+
+func TestSphericalFibonacci_Invalid(t *testing.T) {
+	assert.PanicsWithError(t, "invalid spherical fibonacci point count: 0", func() {
+		vector3.SphericalFibonacci(0, 0)
+	})
+	assert.PanicsWithError(t, "invalid spherical fibonacci point count: -4", func() {
+		vector3.EncodeSphericalFibonacci(vector3.New(1., 0., 0.), -4)
+	})
+	assert.PanicsWithError(t, "invalid spherical fibonacci point count: 4294967297", func() {
+		vector3.Float64Array{}.EncodeSphericalFibonacci(1<<32 + 1)
+	})
+	assert.PanicsWithError(t, "spherical fibonacci index 10 out of range for 10 points", func() {
+		vector3.SphericalFibonacci(10, 10)
+	})
+}
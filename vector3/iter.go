@@ -0,0 +1,131 @@
+package vector3
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+
+	"github.com/EliCDavis/vector"
+)
+
+// All returns an iterator over the index and value of every vector in the
+// array
+func (v3a Array[T]) All() iter.Seq2[int, Vector[T]] {
+	return func(yield func(int, Vector[T]) bool) {
+		for i, v := range v3a {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over every vector in the array
+func (v3a Array[T]) Values() iter.Seq[Vector[T]] {
+	return func(yield func(Vector[T]) bool) {
+		for _, v := range v3a {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Segments returns an iterator over each pair of consecutive vectors in the
+// array, treating it as a path
+func (v3a Array[T]) Segments() iter.Seq2[Vector[T], Vector[T]] {
+	return func(yield func(Vector[T], Vector[T]) bool) {
+		for i := 1; i < len(v3a); i++ {
+			if !yield(v3a[i-1], v3a[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Windows returns an iterator over every run of size consecutive vectors in
+// the array, stepping forward one vector at a time. Each window shares
+// memory with the array rather than being copied.
+func (v3a Array[T]) Windows(size int) iter.Seq[Array[T]] {
+	if size < 1 {
+		panic(fmt.Errorf("invalid window size: %d", size))
+	}
+	return func(yield func(Array[T]) bool) {
+		for i := size; i <= len(v3a); i++ {
+			if !yield(v3a[i-size : i : i]) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator that lazily applies f to every vector produced by
+// seq
+func Map[T, U vector.Number](seq iter.Seq[Vector[T]], f func(Vector[T]) Vector[U]) iter.Seq[Vector[U]] {
+	return func(yield func(Vector[U]) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers every vector produced by seq into a new array
+func Collect[T vector.Number](seq iter.Seq[Vector[T]]) Array[T] {
+	out := make(Array[T], 0)
+	for v := range seq {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Grid returns an iterator over a lattice of points evenly spaced across the
+// bounding box, with the number of points along each axis determined by
+// counts. Points lie on the bounding box's faces, except for axes with a
+// count of 1, which are placed at the box's center. Points are produced with
+// X changing fastest, then Y, then Z.
+func Grid(bounds AABB[float64], counts Vector[int]) iter.Seq[Float64] {
+	if counts.x < 0 || counts.y < 0 || counts.z < 0 {
+		panic(fmt.Errorf("invalid grid counts: %v", counts))
+	}
+
+	axis := func(lo, hi float64, count, i int) float64 {
+		if count == 1 {
+			return (lo + hi) / 2
+		}
+		return lo + (hi-lo)*float64(i)/float64(count-1)
+	}
+
+	return func(yield func(Float64) bool) {
+		for z := 0; z < counts.z; z++ {
+			pz := axis(bounds.min.z, bounds.max.z, counts.z, z)
+			for y := 0; y < counts.y; y++ {
+				py := axis(bounds.min.y, bounds.max.y, counts.y, y)
+				for x := 0; x < counts.x; x++ {
+					if !yield(Float64{x: axis(bounds.min.x, bounds.max.x, counts.x, x), y: py, z: pz}) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// RandSamples returns an iterator over n points sampled uniformly at random
+// from within the bounding box. A negative n produces samples endlessly.
+func RandSamples(r *rand.Rand, bounds AABB[float64], n int) iter.Seq[Float64] {
+	size := bounds.Size()
+	return func(yield func(Float64) bool) {
+		for i := 0; n < 0 || i < n; i++ {
+			sample := Float64{
+				x: bounds.min.x + r.Float64()*size.x,
+				y: bounds.min.y + r.Float64()*size.y,
+				z: bounds.min.z + r.Float64()*size.z,
+			}
+			if !yield(sample) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package vector3_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_All(t *testing.T) {
+	arr := vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.), vector3.New(7., 8., 9.)}
+
+	indices := make([]int, 0)
+	values := make(vector3.Float64Array, 0)
+	for i, v := range arr.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, arr, values)
+
+	assert.Equal(t, arr, vector3.Collect(arr.Values()))
+}
+
+func TestArray_StopsEarly(t *testing.T) {
+	arr := vector3.Float64Array{vector3.New(1., 2., 3.), vector3.New(4., 5., 6.), vector3.New(7., 8., 9.)}
+
+	count := 0
+	for range arr.Values() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+
+	count = 0
+	for i := range arr.All() {
+		count++
+		if i == 1 {
+			break
+		}
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestArray_Segments(t *testing.T) {
+	arr := vector3.Float64Array{vector3.New(0., 0., 0.), vector3.New(0., 3., 4.), vector3.New(0., 3., 10.)}
+
+	total := 0.
+	count := 0
+	for a, b := range arr.Segments() {
+		total += a.Distance(b)
+		count++
+	}
+	assert.Equal(t, 2, count)
+	assert.InDelta(t, arr.Distance(), total, 0.000001)
+
+	single := vector3.Float64Array{vector3.One[float64]()}
+	for range single.Segments() {
+		assert.Fail(t, "single vector has no segments")
+	}
+}
+
+func TestArray_Windows(t *testing.T) {
+	arr := vector3.IntArray{vector3.Fill(1), vector3.Fill(2), vector3.Fill(3), vector3.Fill(4)}
+
+	windows := make([]vector3.IntArray, 0)
+	for w := range arr.Windows(3) {
+		windows = append(windows, w)
+	}
+	assert.Equal(t, []vector3.IntArray{
+		{vector3.Fill(1), vector3.Fill(2), vector3.Fill(3)},
+		{vector3.Fill(2), vector3.Fill(3), vector3.Fill(4)},
+	}, windows)
+
+	// Windows share memory with the array, but can't be appended into it
+	windows[0][0] = vector3.Fill(10)
+	assert.Equal(t, vector3.Fill(10), arr[0])
+	assert.Equal(t, 3, cap(windows[0]))
+
+	for range arr.Windows(5) {
+		assert.Fail(t, "window larger than array")
+	}
+
+	assert.PanicsWithError(t, "invalid window size: 0", func() {
+		arr.Windows(0)
+	})
+}
+
+func TestMap(t *testing.T) {
+	arr := vector3.Float64Array{vector3.New(1.2, 2.7, -3.5), vector3.New(4., 5.5, 6.1)}
+	rounded := vector3.Collect(vector3.Map(arr.Values(), vector3.Float64.RoundToInt))
+	assert.Equal(t, vector3.IntArray{vector3.New(1, 3, -4), vector3.New(4, 6, 6)}, rounded)
+
+	scaled := vector3.Collect(vector3.Map(arr.Values(), func(v vector3.Float64) vector3.Float64 { return v.Scale(2) }))
+	assert.Equal(t, arr.Scale(2), scaled)
+}
+
+func TestGrid(t *testing.T) {
+	bounds := vector3.NewAABB(vector3.New(0., 0., 0.), vector3.New(2., 4., 6.))
+
+	points := vector3.Collect(vector3.Grid(bounds, vector3.New(3, 2, 1)))
+	assert.Equal(t, vector3.Float64Array{
+		vector3.New(0., 0., 3.),
+		vector3.New(1., 0., 3.),
+		vector3.New(2., 0., 3.),
+		vector3.New(0., 4., 3.),
+		vector3.New(1., 4., 3.),
+		vector3.New(2., 4., 3.),
+	}, points)
+
+	assert.Len(t, vector3.Collect(vector3.Grid(bounds, vector3.New(10, 10, 10))), 1000)
+	assert.Len(t, vector3.Collect(vector3.Grid(bounds, vector3.New(10, 0, 10))), 0)
+
+	assert.PanicsWithError(t, "invalid grid counts: {1 -1 1}", func() {
+		vector3.Grid(bounds, vector3.New(1, -1, 1))
+	})
+}
+
+func TestRandSamples(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	bounds := vector3.NewAABB(vector3.New(-1., 2., 3.), vector3.New(1., 5., 3.5))
+
+	samples := vector3.Collect(vector3.RandSamples(r, bounds, 500))
+	assert.Len(t, samples, 500)
+	for _, s := range samples {
+		assert.True(t, bounds.Contains(s))
+	}
+
+	// Endless samples stop when the consumer does
+	count := 0
+	for range vector3.RandSamples(r, bounds, -1) {
+		count++
+		if count == 2000 {
+			break
+		}
+	}
+	assert.Equal(t, 2000, count)
+}
+
+func TestGenerators_Compose(t *testing.T) {
+	bounds := vector3.NewAABB(vector3.Fill(-1.), vector3.Fill(1.))
+	lengths := vector3.Map(vector3.Grid(bounds, vector3.Fill(5)), vector3.Float64.Normalized)
+
+	count := 0
+	for v := range lengths {
+		if v.ContainsNaN() {
+			continue
+		}
+		assert.InDelta(t, 1., v.Length(), 0.000001)
+		count++
+	}
+	assert.Equal(t, 124, count)
+}
@@ -0,0 +1,148 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector2"
+)
+
+// signNotZero returns -1 for negative numbers and 1 otherwise
+func signNotZero(f float64) float64 {
+	if f < 0 {
+		return -1
+	}
+	return 1
+}
+
+// OctahedralMap projects the direction of the vector onto an octahedron
+// which is then unfolded into the square [-1, 1]², as described in "A Survey
+// of Efficient Representations for Independent Unit Vectors" (Cigolle et al.
+// 2014). The vector does not need to be normalized. The zero vector maps to
+// the same point as +Z.
+func (v Vector[T]) OctahedralMap() vector2.Float64 {
+	x, y, z := float64(v.x), float64(v.y), float64(v.z)
+	l1 := math.Abs(x) + math.Abs(y) + math.Abs(z)
+	if l1 == 0 {
+		return vector2.Zero[float64]()
+	}
+	x, y, z = x/l1, y/l1, z/l1
+
+	if z < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	return vector2.New(x, y)
+}
+
+// FromOctahedralMap converts a point within [-1, 1]² produced by
+// OctahedralMap back into a unit vector
+func FromOctahedralMap(p vector2.Float64) Float64 {
+	x, y := p.X(), p.Y()
+	z := 1 - math.Abs(x) - math.Abs(y)
+	if z < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	return New(x, y, z).Normalized()
+}
+
+// encodeOctahedral quantizes the octahedral mapping of the vector into
+// integers within [-limit, limit]. Rather than simply rounding, every
+// combination of rounding each component up or down is considered, and the
+// one that decodes closest to the original direction is kept.
+func encodeOctahedral(v Float64, limit float64) (int, int) {
+	p := v.OctahedralMap()
+	dir := v.Normalized()
+	if dir.ContainsNaN() {
+		dir = Forward[float64]()
+	}
+
+	u, w := p.X()*limit, p.Y()*limit
+	bestU, bestW := 0., 0.
+	bestDot := math.Inf(-1)
+	for _, cu := range [2]float64{math.Floor(u), math.Ceil(u)} {
+		for _, cw := range [2]float64{math.Floor(w), math.Ceil(w)} {
+			cu, cw = vector.Clamp(cu, -limit, limit), vector.Clamp(cw, -limit, limit)
+			dot := FromOctahedralMap(vector2.New(cu/limit, cw/limit)).Dot(dir)
+			if dot > bestDot {
+				bestU, bestW, bestDot = cu, cw, dot
+			}
+		}
+	}
+	return int(bestU), int(bestW)
+}
+
+// EncodeOctahedral16 compresses the direction of the vector into 16 bits,
+// storing each component of its octahedral mapping as an 8 bit SNORM value.
+// The first component occupies the low byte. The maximum angular error is
+// under 0.7 degrees.
+func EncodeOctahedral16[T vector.Number](v Vector[T]) uint16 {
+	u, w := encodeOctahedral(v.ToFloat64(), math.MaxInt8)
+	return uint16(uint8(int8(u))) | uint16(uint8(int8(w)))<<8
+}
+
+// DecodeOctahedral16 converts a value produced by EncodeOctahedral16 back
+// into a unit vector
+func DecodeOctahedral16(encoded uint16) Float64 {
+	return FromOctahedralMap(vector2.New(
+		vector.DecodeSNORM8(int8(encoded)),
+		vector.DecodeSNORM8(int8(encoded>>8)),
+	))
+}
+
+// EncodeOctahedral32 compresses the direction of the vector into 32 bits,
+// storing each component of its octahedral mapping as a 16 bit SNORM value.
+// The first component occupies the low 16 bits. The maximum angular error is
+// under 0.003 degrees.
+func EncodeOctahedral32[T vector.Number](v Vector[T]) uint32 {
+	u, w := encodeOctahedral(v.ToFloat64(), math.MaxInt16)
+	return uint32(uint16(int16(u))) | uint32(uint16(int16(w)))<<16
+}
+
+// DecodeOctahedral32 converts a value produced by EncodeOctahedral32 back
+// into a unit vector
+func DecodeOctahedral32(encoded uint32) Float64 {
+	return FromOctahedralMap(vector2.New(
+		vector.DecodeSNORM16(int16(encoded)),
+		vector.DecodeSNORM16(int16(encoded>>16)),
+	))
+}
+
+// EncodeOctahedral16 compresses the direction of every vector in the array
+// with EncodeOctahedral16
+func (v3a Array[T]) EncodeOctahedral16() []uint16 {
+	out := make([]uint16, len(v3a))
+	for i, v := range v3a {
+		out[i] = EncodeOctahedral16(v)
+	}
+	return out
+}
+
+// DecodeOctahedral16Array converts values produced by EncodeOctahedral16
+// back into unit vectors
+func DecodeOctahedral16Array(encoded []uint16) Float64Array {
+	out := make(Float64Array, len(encoded))
+	for i, e := range encoded {
+		out[i] = DecodeOctahedral16(e)
+	}
+	return out
+}
+
+// EncodeOctahedral32 compresses the direction of every vector in the array
+// with EncodeOctahedral32
+func (v3a Array[T]) EncodeOctahedral32() []uint32 {
+	out := make([]uint32, len(v3a))
+	for i, v := range v3a {
+		out[i] = EncodeOctahedral32(v)
+	}
+	return out
+}
+
+// DecodeOctahedral32Array converts values produced by EncodeOctahedral32
+// back into unit vectors
+func DecodeOctahedral32Array(encoded []uint32) Float64Array {
+	out := make(Float64Array, len(encoded))
+	for i, e := range encoded {
+		out[i] = DecodeOctahedral32(e)
+	}
+	return out
+}
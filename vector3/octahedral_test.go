@@ -0,0 +1,118 @@
+package vector3_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector2"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+// randomDirections returns unit vectors distributed uniformly over the
+// sphere, along with each axis and a handful of edge cases
+func randomDirections(r *rand.Rand, n int) vector3.Float64Array {
+	out := vector3.Float64Array{
+		vector3.New(1., 0., 0.), vector3.New(-1., 0., 0.),
+		vector3.New(0., 1., 0.), vector3.New(0., -1., 0.),
+		vector3.New(0., 0., 1.), vector3.New(0., 0., -1.),
+		vector3.New(1., 1., 0.).Normalized(),
+		vector3.New(-1., 1., -1.).Normalized(),
+		vector3.New(1e-9, -1e-9, -1.).Normalized(),
+	}
+	for len(out) < n {
+		out = append(out, vector3.New(r.NormFloat64(), r.NormFloat64(), r.NormFloat64()).Normalized())
+	}
+	return out
+}
+
+func TestOctahedralMap_RoundTrip(t *testing.T) {
+	for _, v := range randomDirections(rand.New(rand.NewSource(42)), 1000) {
+		p := v.OctahedralMap()
+		assert.LessOrEqual(t, math.Abs(p.X()), 1.)
+		assert.LessOrEqual(t, math.Abs(p.Y()), 1.)
+
+		back := vector3.FromOctahedralMap(p)
+		assert.InDelta(t, v.X(), back.X(), 1e-12)
+		assert.InDelta(t, v.Y(), back.Y(), 1e-12)
+		assert.InDelta(t, v.Z(), back.Z(), 1e-12)
+	}
+}
+
+func TestOctahedralMap_IgnoresLength(t *testing.T) {
+	v := vector3.New(1., -2., -3.)
+	assert.Equal(t, v.Normalized().OctahedralMap(), v.Scale(10).OctahedralMap())
+	assert.Equal(t, vector2.Zero[float64](), vector3.Zero[float64]().OctahedralMap())
+	assert.Equal(t, vector3.New(1, -2, -3).OctahedralMap(), v.OctahedralMap())
+}
+
+func TestOctahedral_ErrorBounds(t *testing.T) {
+	directions := randomDirections(rand.New(rand.NewSource(42)), 200_000)
+
+	tests := map[string]struct {
+		roundTrip func(vector3.Float64) vector3.Float64
+		maxError  float64
+	}{
+		"16 bit": {
+			roundTrip: func(v vector3.Float64) vector3.Float64 {
+				return vector3.DecodeOctahedral16(vector3.EncodeOctahedral16(v))
+			},
+			maxError: 0.7,
+		},
+		"32 bit": {
+			roundTrip: func(v vector3.Float64) vector3.Float64 {
+				return vector3.DecodeOctahedral32(vector3.EncodeOctahedral32(v))
+			},
+			maxError: 0.003,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			worst := 0.
+			for _, v := range directions {
+				back := tc.roundTrip(v)
+				assert.InDelta(t, 1, back.Length(), 1e-12)
+				worst = max(worst, v.Angle(back))
+			}
+			assert.Less(t, worst*180/math.Pi, tc.maxError)
+		})
+	}
+}
+
+func TestOctahedral_Layout(t *testing.T) {
+	// +Z maps to the center of the square, and +X to its right edge
+	assert.Equal(t, uint16(0), vector3.EncodeOctahedral16(vector3.New(0., 0., 1.)))
+	assert.Equal(t, uint16(0x007f), vector3.EncodeOctahedral16(vector3.New(1., 0., 0.)))
+	assert.Equal(t, uint16(0x8100), vector3.EncodeOctahedral16(vector3.New(0., -1., 0.)))
+	assert.Equal(t, uint32(0x00007fff), vector3.EncodeOctahedral32(vector3.New(2, 0, 0)))
+	assert.Equal(t, uint32(0), vector3.EncodeOctahedral32(vector3.Zero[float32]()))
+
+	assert.Equal(t, vector3.New(1., 0., 0.), vector3.DecodeOctahedral16(0x007f))
+	assert.Equal(t, vector3.New(0., -1., 0.), vector3.DecodeOctahedral32(0x80010000))
+}
+
+func TestOctahedral_Array(t *testing.T) {
+	directions := randomDirections(rand.New(rand.NewSource(42)), 1000)
+	asFloat32 := make(vector3.Float32Array, len(directions))
+	for i, v := range directions {
+		asFloat32[i] = v.ToFloat32()
+	}
+
+	encoded16 := asFloat32.EncodeOctahedral16()
+	encoded32 := directions.EncodeOctahedral32()
+	assert.Len(t, encoded16, len(directions))
+	assert.Len(t, encoded32, len(directions))
+
+	decoded16 := vector3.DecodeOctahedral16Array(encoded16)
+	decoded32 := vector3.DecodeOctahedral32Array(encoded32)
+	for i, v := range directions {
+		assert.Equal(t, vector3.EncodeOctahedral16(asFloat32[i]), encoded16[i])
+		assert.Equal(t, vector3.DecodeOctahedral16(encoded16[i]), decoded16[i])
+		assert.Less(t, v.Angle(decoded32[i])*180/math.Pi, 0.003)
+	}
+
+	assert.Empty(t, vector3.Float64Array{}.EncodeOctahedral16())
+	assert.Empty(t, vector3.DecodeOctahedral32Array(nil))
+}
@@ -0,0 +1,240 @@
+package vector3
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/EliCDavis/vector"
+)
+
+// parallelChunkSize is the number of elements processed per unit of work.
+// Reductions are computed per chunk and then combined in order, so results
+// don't depend on how many workers are used.
+const parallelChunkSize = 1 << 14
+
+// ParallelArray performs bulk operations on an Array split across multiple
+// goroutines. For small arrays the cost of coordinating goroutines outweighs
+// the gains, see the Parallel benchmarks within the experiments package for
+// where the crossover lies on a given machine.
+type ParallelArray[T vector.Number] struct {
+	arr     Array[T]
+	workers int
+}
+
+// Parallel wraps the array so bulk operations are split across the number
+// of workers provided. A worker count of 0 or less uses GOMAXPROCS.
+func (v3a Array[T]) Parallel(workers int) ParallelArray[T] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return ParallelArray[T]{arr: v3a, workers: workers}
+}
+
+// Array returns the underlying array
+func (p ParallelArray[T]) Array() Array[T] {
+	return p.arr
+}
+
+// Workers returns the maximum number of goroutines used per operation
+func (p ParallelArray[T]) Workers() int {
+	return p.workers
+}
+
+func (p ParallelArray[T]) chunks() int {
+	return (len(p.arr) + parallelChunkSize - 1) / parallelChunkSize
+}
+
+// forEachChunk calls f once per chunk of the array, spread across the
+// workers
+func (p ParallelArray[T]) forEachChunk(f func(chunk, start, end int)) {
+	chunks := p.chunks()
+	workers := min(p.workers, chunks)
+
+	run := func(chunk int) {
+		start := chunk * parallelChunkSize
+		f(chunk, start, min(start+parallelChunkSize, len(p.arr)))
+	}
+
+	if workers <= 1 {
+		for c := 0; c < chunks; c++ {
+			run(c)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for c := int(next.Add(1) - 1); c < chunks; c = int(next.Add(1) - 1) {
+				run(c)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (p ParallelArray[T]) apply(out Array[T], f func(Vector[T]) Vector[T]) Array[T] {
+	p.forEachChunk(func(_, start, end int) {
+		for i := start; i < end; i++ {
+			out[i] = f(p.arr[i])
+		}
+	})
+	return out
+}
+
+func (p ParallelArray[T]) Add(other Vector[T]) Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), func(v Vector[T]) Vector[T] { return v.Add(other) })
+}
+
+func (p ParallelArray[T]) AddInplace(other Vector[T]) Array[T] {
+	return p.apply(p.arr, func(v Vector[T]) Vector[T] { return v.Add(other) })
+}
+
+func (p ParallelArray[T]) Sub(other Vector[T]) Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), func(v Vector[T]) Vector[T] { return v.Sub(other) })
+}
+
+func (p ParallelArray[T]) SubInplace(other Vector[T]) Array[T] {
+	return p.apply(p.arr, func(v Vector[T]) Vector[T] { return v.Sub(other) })
+}
+
+func (p ParallelArray[T]) Scale(t float64) Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), func(v Vector[T]) Vector[T] { return v.Scale(t) })
+}
+
+func (p ParallelArray[T]) ScaleInplace(t float64) Array[T] {
+	return p.apply(p.arr, func(v Vector[T]) Vector[T] { return v.Scale(t) })
+}
+
+func (p ParallelArray[T]) DivByConstant(t float64) Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), func(v Vector[T]) Vector[T] { return v.DivByConstant(t) })
+}
+
+func (p ParallelArray[T]) Normalized() Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), Vector[T].Normalized)
+}
+
+// Modify builds a new array from the result of calling f on every element.
+// f is called from multiple goroutines at once, so must be safe for
+// concurrent use.
+func (p ParallelArray[T]) Modify(f func(Vector[T]) Vector[T]) Array[T] {
+	return p.apply(make(Array[T], len(p.arr)), f)
+}
+
+func (p ParallelArray[T]) ContainsNaN() bool {
+	var found atomic.Bool
+	p.forEachChunk(func(_, start, end int) {
+		if found.Load() {
+			return
+		}
+		if p.arr[start:end].ContainsNaN() {
+			found.Store(true)
+		}
+	})
+	return found.Load()
+}
+
+func (p ParallelArray[T]) MaxLength() float64 {
+	partials := make([]float64, p.chunks())
+	p.forEachChunk(func(chunk, start, end int) {
+		partials[chunk] = p.arr[start:end].MaxLength()
+	})
+
+	max := 0.
+	for _, v := range partials {
+		max = math.Max(max, v)
+	}
+	return max
+}
+
+// Distance returns the total length of the path formed by visiting each
+// vector in order
+func (p ParallelArray[T]) Distance() float64 {
+	partials := make([]float64, p.chunks())
+	p.forEachChunk(func(chunk, start, end int) {
+		// Include the segment joining this chunk to the previous one
+		partials[chunk] = p.arr[max(0, start-1):end].Distance()
+	})
+
+	total := 0.
+	for _, v := range partials {
+		total += v
+	}
+	return total
+}
+
+// Sum adds every vector together. Partial sums are computed per fixed size
+// chunk and combined in order, so the result is the same regardless of
+// worker count, but floating point results may differ slightly from the
+// sequential Array.Sum.
+func (p ParallelArray[T]) Sum() (sum Vector[T]) {
+	partials := make([]Vector[T], p.chunks())
+	p.forEachChunk(func(chunk, start, end int) {
+		partials[chunk] = p.arr[start:end].Sum()
+	})
+
+	for _, v := range partials {
+		sum = sum.Add(v)
+	}
+	return
+}
+
+// sumFloat64 computes the deterministic sum of f applied to every element
+func (p ParallelArray[T]) sumFloat64(f func(Vector[T]) Vector[float64]) (sum Vector[float64]) {
+	partials := make([]Vector[float64], p.chunks())
+	p.forEachChunk(func(chunk, start, end int) {
+		var partial Vector[float64]
+		for _, v := range p.arr[start:end] {
+			partial = partial.Add(f(v))
+		}
+		partials[chunk] = partial
+	})
+
+	for _, v := range partials {
+		sum = sum.Add(v)
+	}
+	return
+}
+
+// Average sums all vector3's components together and divides each
+// component by the number of values added. Like Sum, the result does not
+// depend on the worker count.
+func (p ParallelArray[T]) Average() Vector[float64] {
+	return p.sumFloat64(Vector[T].ToFloat64).DivByConstant(float64(len(p.arr)))
+}
+
+// Bounds returns the min and max points of an AABB encompassing
+func (p ParallelArray[T]) Bounds() (Vector[T], Vector[T]) {
+	if len(p.arr) == 0 {
+		panic(errors.New("can not compute bounds from 0 vector elements"))
+	}
+
+	mins := make([]Vector[T], p.chunks())
+	maxs := make([]Vector[T], p.chunks())
+	p.forEachChunk(func(chunk, start, end int) {
+		mins[chunk], maxs[chunk] = p.arr[start:end].Bounds()
+	})
+
+	lo, _ := Array[T](mins).Bounds()
+	_, hi := Array[T](maxs).Bounds()
+	return lo, hi
+}
+
+// StandardDeviation calculates the population standard deviation on each
+// component of the vector
+func (p ParallelArray[T]) StandardDeviation() (mean, deviation Vector[float64]) {
+	mean = p.Average()
+	squared := p.sumFloat64(func(v Vector[T]) Vector[float64] {
+		diff := v.ToFloat64().Sub(mean)
+		return diff.MultByVector(diff)
+	})
+
+	deviation = squared.DivByConstant(float64(len(p.arr))).Sqrt()
+	return
+}
@@ -0,0 +1,147 @@
+package vector3_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+var workerCounts = []int{0, 1, 2, 7, 32}
+
+func TestParallel_Workers(t *testing.T) {
+	arr := vector3.Float64Array{}
+	assert.Equal(t, 3, arr.Parallel(3).Workers())
+	assert.Greater(t, arr.Parallel(0).Workers(), 0)
+	assert.Greater(t, arr.Parallel(-1).Workers(), 0)
+}
+
+func TestParallel_ElementWiseMatchesSequential(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100_000)
+	other := vector3.New(1., -2., 3.)
+
+	tests := map[string]struct {
+		sequential func(vector3.Float64Array) vector3.Float64Array
+		parallel   func(vector3.ParallelArray[float64]) vector3.Float64Array
+	}{
+		"add": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.Add(other) },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.Add(other) },
+		},
+		"sub": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.Sub(other) },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.Sub(other) },
+		},
+		"scale": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.Scale(1.5) },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.Scale(1.5) },
+		},
+		"div by constant": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.DivByConstant(3) },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.DivByConstant(3) },
+		},
+		"normalized": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.Normalized() },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.Normalized() },
+		},
+		"modify": {
+			sequential: func(a vector3.Float64Array) vector3.Float64Array { return a.Modify(vector3.Float64.Abs) },
+			parallel:   func(p vector3.ParallelArray[float64]) vector3.Float64Array { return p.Modify(vector3.Float64.Abs) },
+		},
+	}
+
+	for name, tc := range tests {
+		for _, workers := range workerCounts {
+			t.Run(fmt.Sprintf("%s/workers-%d", name, workers), func(t *testing.T) {
+				assert.Equal(t, tc.sequential(arr), tc.parallel(arr.Parallel(workers)))
+			})
+		}
+	}
+}
+
+func TestParallel_InplaceMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := randomArray(r, 50_000)
+	want := arr.Add(vector3.One[float64]()).Scale(2).Sub(vector3.Fill(3.))
+
+	parallel := arr.Parallel(4)
+	parallel.AddInplace(vector3.One[float64]())
+	parallel.ScaleInplace(2)
+	out := parallel.SubInplace(vector3.Fill(3.))
+
+	assert.Equal(t, want, arr)
+	assert.Equal(t, want, out)
+	assert.Equal(t, want, parallel.Array())
+}
+
+func TestParallel_ReductionsAreDeterministic(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 200_000)
+	sequentialMean, sequentialDeviation := arr.StandardDeviation()
+
+	first := arr.Parallel(1)
+	firstMean, firstDeviation := first.StandardDeviation()
+
+	for _, workers := range workerCounts {
+		t.Run(fmt.Sprintf("workers-%d", workers), func(t *testing.T) {
+			p := arr.Parallel(workers)
+
+			// Identical regardless of worker count
+			assert.Equal(t, first.Sum(), p.Sum())
+			assert.Equal(t, first.Average(), p.Average())
+			mean, deviation := p.StandardDeviation()
+			assert.Equal(t, firstMean, mean)
+			assert.Equal(t, firstDeviation, deviation)
+
+			// And close to the sequential result
+			sum := p.Sum()
+			sequentialSum := arr.Sum()
+			assert.InDelta(t, sequentialSum.X(), sum.X(), 1e-6)
+			assert.InDelta(t, sequentialSum.Y(), sum.Y(), 1e-6)
+			assert.InDelta(t, sequentialSum.Z(), sum.Z(), 1e-6)
+			assert.InDelta(t, sequentialMean.X(), mean.X(), 1e-9)
+			assert.InDelta(t, sequentialMean.Y(), mean.Y(), 1e-9)
+			assert.InDelta(t, sequentialMean.Z(), mean.Z(), 1e-9)
+			assert.InDelta(t, sequentialDeviation.X(), deviation.X(), 1e-9)
+			assert.InDelta(t, sequentialDeviation.Y(), deviation.Y(), 1e-9)
+			assert.InDelta(t, sequentialDeviation.Z(), deviation.Z(), 1e-9)
+
+			// Order independent reductions match exactly
+			assert.Equal(t, arr.MaxLength(), p.MaxLength())
+			min, max := arr.Bounds()
+			pMin, pMax := p.Bounds()
+			assert.Equal(t, min, pMin)
+			assert.Equal(t, max, pMax)
+			assert.InDelta(t, arr.Distance(), p.Distance(), 1e-6)
+		})
+	}
+}
+
+func TestParallel_IntSumMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector3.IntArray, 100_000)
+	for i := range arr {
+		arr[i] = vector3.New(r.Intn(100), r.Intn(100), r.Intn(100))
+	}
+	assert.Equal(t, arr.Sum(), arr.Parallel(8).Sum())
+}
+
+func TestParallel_ContainsNaN(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100_000)
+	assert.False(t, arr.Parallel(4).ContainsNaN())
+
+	arr[77_777] = vector3.New(0., math.NaN(), 0.)
+	assert.True(t, arr.Parallel(4).ContainsNaN())
+}
+
+func TestParallel_Empty(t *testing.T) {
+	p := vector3.Float64Array{}.Parallel(4)
+	assert.Equal(t, vector3.Zero[float64](), p.Sum())
+	assert.Len(t, p.Add(vector3.One[float64]()), 0)
+	assert.Equal(t, 0., p.Distance())
+	assert.PanicsWithError(t, "can not compute bounds from 0 vector elements", func() {
+		p.Bounds()
+	})
+}
@@ -0,0 +1,167 @@
+package vector3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type arrayReadWriteTestCase[T vector.Number] struct {
+	len int
+}
+
+func (tc arrayReadWriteTestCase[T]) test(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector3.Array[T], tc.len)
+	for i := range arr {
+		arr[i] = vector3.New(T(r.NormFloat64()*100), T(r.NormFloat64()*100), T(r.NormFloat64()*100))
+	}
+
+	for _, endian := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		// Matches writing each vector individually
+		individually := &bytes.Buffer{}
+		for _, v := range arr {
+			assert.NoError(t, v.Write(individually, endian))
+		}
+
+		buf := &bytes.Buffer{}
+		assert.NoError(t, arr.Write(buf, endian))
+		assert.Equal(t, individually.Bytes(), buf.Bytes())
+
+		back, err := vector3.ReadArray[T](bytes.NewReader(buf.Bytes()), endian, len(arr))
+		assert.NoError(t, err)
+		assert.Equal(t, arr, back)
+
+		into := make(vector3.Array[T], len(arr))
+		assert.NoError(t, vector3.ReadArrayInto(bytes.NewReader(buf.Bytes()), endian, into))
+		assert.Equal(t, arr, into)
+
+		withHeader := &bytes.Buffer{}
+		assert.NoError(t, arr.WriteWithHeader(withHeader, endian))
+		assert.Equal(t, buf.Len()+10, withHeader.Len())
+
+		back, err = vector3.ReadArrayWithHeader[T](withHeader, endian)
+		assert.NoError(t, err)
+		assert.Equal(t, arr, back)
+	}
+}
+
+func TestArrayReadWrite(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64":       arrayReadWriteTestCase[float64]{len: 100},
+		"float64 large": arrayReadWriteTestCase[float64]{len: 10_000},
+		"float32":       arrayReadWriteTestCase[float32]{len: 10_000},
+		"int8":          arrayReadWriteTestCase[int8]{len: 100},
+		"int16":         arrayReadWriteTestCase[int16]{len: 100},
+		"int32":         arrayReadWriteTestCase[int32]{len: 10_000},
+		"int64":         arrayReadWriteTestCase[int64]{len: 100},
+		"empty":         arrayReadWriteTestCase[float32]{len: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestReadArrayHeader(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := vector3.Float32Array{vector3.New[float32](1, 2, 3), vector3.New[float32](4, 5, 6)}
+	assert.NoError(t, arr.WriteWithHeader(buf, binary.BigEndian))
+
+	data := buf.Bytes()
+	count, err := vector3.ReadArrayHeader[float32](bytes.NewReader(data), binary.BigEndian)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = vector3.ReadArrayHeader[float64](bytes.NewReader(data), binary.BigEndian)
+	assert.EqualError(t, err, "array header component type 5 does not match expected type 6")
+
+	vector2Header := append([]byte{5, 2}, data[2:]...)
+	_, err = vector3.ReadArrayHeader[float32](bytes.NewReader(vector2Header), binary.BigEndian)
+	assert.EqualError(t, err, "array header component count 2 does not match expected count 3")
+
+	_, err = vector3.ReadArrayHeader[float32](bytes.NewReader(data[:4]), binary.BigEndian)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestReadArray_Truncated(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := make(vector3.Float64Array, 5000)
+	assert.NoError(t, arr.WriteWithHeader(buf, binary.BigEndian))
+
+	_, err := vector3.ReadArrayWithHeader[float64](bytes.NewReader(buf.Bytes()[:buf.Len()-1]), binary.BigEndian)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = vector3.ReadArray[float64](bytes.NewReader(buf.Bytes()[10:100]), binary.LittleEndian, 5000)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestArrayWrite_Error(t *testing.T) {
+	arr := vector3.Float64Array{vector3.One[float64]()}
+	assert.EqualError(t, arr.Write(failingWriter{}, binary.BigEndian), "disk full")
+	assert.EqualError(t, arr.WriteWithHeader(failingWriter{}, binary.LittleEndian), "disk full")
+}
+
+func TestArrayReadWrite_Int(t *testing.T) {
+	arr := vector3.IntArray{vector3.New(1, -2, 3), vector3.New(-1<<40, 5, 1<<50)}
+
+	for _, endian := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		buf := &bytes.Buffer{}
+		assert.NoError(t, arr.WriteWithHeader(buf, endian))
+		data := buf.Bytes()
+
+		back, err := vector3.ReadArrayWithHeader[int](bytes.NewReader(data), endian)
+		assert.NoError(t, err)
+		assert.Equal(t, arr, back)
+
+		// Platform sized ints are stored as int64, under a header code of
+		// their own
+		_, err = vector3.ReadArrayWithHeader[int64](bytes.NewReader(data), endian)
+		assert.EqualError(t, err, "array header component type 7 does not match expected type 4")
+
+		asInt64, err := vector3.ReadArray[int64](bytes.NewReader(data[10:]), endian, len(arr))
+		assert.NoError(t, err)
+		assert.Equal(t, vector3.Int64Array{vector3.New[int64](1, -2, 3), vector3.New[int64](-1<<40, 5, 1<<50)}, asInt64)
+	}
+}
+
+var arrayWriteBuffer bytes.Buffer
+
+func BenchmarkArrayWrite(b *testing.B) {
+	arr := make(vector3.Float64Array, 100_000)
+	b.Run("individual", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			arrayWriteBuffer.Reset()
+			for _, v := range arr {
+				v.Write(&arrayWriteBuffer, binary.LittleEndian)
+			}
+		}
+	})
+	b.Run("little endian", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			arrayWriteBuffer.Reset()
+			arr.Write(&arrayWriteBuffer, binary.LittleEndian)
+		}
+	})
+	b.Run("big endian", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			arrayWriteBuffer.Reset()
+			arr.Write(&arrayWriteBuffer, binary.BigEndian)
+		}
+	})
+}
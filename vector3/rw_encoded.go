@@ -0,0 +1,73 @@
+package vector3
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/EliCDavis/vector"
+)
+
+// WriteEncoded writes the vector with each component stored in the encoding
+// provided, such as half precision floats or normalized integers
+func (v Vector[T]) WriteEncoded(out io.Writer, endian binary.ByteOrder, encoding vector.Encoding) error {
+	return Array[T]{v}.WriteEncoded(out, endian, encoding)
+}
+
+// ReadEncoded reads a vector written by WriteEncoded using the same encoding.
+// Decoded components are converted to T, truncating towards zero for integer
+// types.
+func ReadEncoded[T vector.Number](in io.Reader, endian binary.ByteOrder, encoding vector.Encoding) (Vector[T], error) {
+	out := make(Array[T], 1)
+	err := ReadArrayEncodedInto(in, endian, encoding, out)
+	return out[0], err
+}
+
+// WriteEncoded writes every vector in the array with each component stored
+// in the encoding provided. Vectors are encoded in chunks through a single
+// reused buffer.
+func (v3a Array[T]) WriteEncoded(out io.Writer, endian binary.ByteOrder, encoding vector.Encoding) error {
+	size := encoding.Size()
+	flat := v3a.Flat()
+	perChunk := arrayChunkSize / size
+	buf := make([]byte, min(len(flat), perChunk)*size)
+	for start := 0; start < len(flat); start += perChunk {
+		components := flat[start:min(start+perChunk, len(flat))]
+		for i, c := range components {
+			encoding.Put(buf[i*size:], endian, float64(c))
+		}
+		if _, err := out.Write(buf[:len(components)*size]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadArrayEncodedInto fills the entirety of the preallocated array with
+// vectors written by WriteEncoded using the same encoding
+func ReadArrayEncodedInto[T vector.Number](in io.Reader, endian binary.ByteOrder, encoding vector.Encoding, dst Array[T]) error {
+	size := encoding.Size()
+	flat := dst.Flat()
+	perChunk := arrayChunkSize / size
+	buf := make([]byte, min(len(flat), perChunk)*size)
+	for start := 0; start < len(flat); start += perChunk {
+		components := flat[start:min(start+perChunk, len(flat))]
+		chunk := buf[:len(components)*size]
+		if _, err := io.ReadFull(in, chunk); err != nil {
+			return err
+		}
+		for i := range components {
+			components[i] = T(encoding.Get(chunk[i*size:], endian))
+		}
+	}
+	return nil
+}
+
+// ReadArrayEncoded reads n vectors written by WriteEncoded using the same
+// encoding
+func ReadArrayEncoded[T vector.Number](in io.Reader, endian binary.ByteOrder, encoding vector.Encoding, n int) (Array[T], error) {
+	out := make(Array[T], n)
+	if err := ReadArrayEncodedInto(in, endian, encoding, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
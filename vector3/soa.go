@@ -0,0 +1,270 @@
+package vector3
+
+import (
+	"errors"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+// SoA stores vectors as a struct of arrays, with each component kept in its
+// own slice. Bulk operations over this layout are friendlier to the CPU's
+// cache and vectorization, and it's the layout most numeric and GPU
+// pipelines expect. All slices are expected to be the same length.
+type SoA[T vector.Number] struct {
+	X []T
+	Y []T
+	Z []T
+}
+
+type (
+	Float64SoA = SoA[float64]
+	Float32SoA = SoA[float32]
+	IntSoA     = SoA[int]
+	Int64SoA   = SoA[int64]
+	Int32SoA   = SoA[int32]
+	Int16SoA   = SoA[int16]
+	Int8SoA    = SoA[int8]
+)
+
+// NewSoA allocates storage for n zero vectors
+func NewSoA[T vector.Number](n int) SoA[T] {
+	return SoA[T]{
+		X: make([]T, n),
+		Y: make([]T, n),
+		Z: make([]T, n),
+	}
+}
+
+// ToSoA copies the array into a struct of arrays layout
+func (v3a Array[T]) ToSoA() SoA[T] {
+	out := NewSoA[T](len(v3a))
+	for i, v := range v3a {
+		out.X[i] = v.x
+		out.Y[i] = v.y
+		out.Z[i] = v.z
+	}
+	return out
+}
+
+// ToArray copies the vectors into an array of structs layout
+func (s SoA[T]) ToArray() Array[T] {
+	out := make(Array[T], s.Len())
+	for i := range out {
+		out[i] = Vector[T]{x: s.X[i], y: s.Y[i], z: s.Z[i]}
+	}
+	return out
+}
+
+// Len returns the number of vectors stored
+func (s SoA[T]) Len() int {
+	return len(s.X)
+}
+
+// At returns the vector found at index i
+func (s SoA[T]) At(i int) Vector[T] {
+	return Vector[T]{x: s.X[i], y: s.Y[i], z: s.Z[i]}
+}
+
+// Set overwrites the vector found at index i
+func (s SoA[T]) Set(i int, v Vector[T]) {
+	s.X[i] = v.x
+	s.Y[i] = v.y
+	s.Z[i] = v.z
+}
+
+func (s SoA[T]) Add(other Vector[T]) SoA[T] {
+	return s.clone().AddInplace(other)
+}
+
+func (s SoA[T]) AddInplace(other Vector[T]) SoA[T] {
+	addScalar(s.X, other.x)
+	addScalar(s.Y, other.y)
+	addScalar(s.Z, other.z)
+	return s
+}
+
+func (s SoA[T]) Sub(other Vector[T]) SoA[T] {
+	return s.clone().SubInplace(other)
+}
+
+func (s SoA[T]) SubInplace(other Vector[T]) SoA[T] {
+	addScalar(s.X, -other.x)
+	addScalar(s.Y, -other.y)
+	addScalar(s.Z, -other.z)
+	return s
+}
+
+// Distance returns the total length of the path formed by visiting each
+// vector in order
+func (s SoA[T]) Distance() (total float64) {
+	for i := 1; i < s.Len(); i++ {
+		dx := float64(s.X[i]) - float64(s.X[i-1])
+		dy := float64(s.Y[i]) - float64(s.Y[i-1])
+		dz := float64(s.Z[i]) - float64(s.Z[i-1])
+		total += math.Sqrt(dx*dx + dy*dy + dz*dz)
+	}
+	return
+}
+
+func (s SoA[T]) Scale(t float64) SoA[T] {
+	return s.clone().ScaleInplace(t)
+}
+
+func (s SoA[T]) ScaleInplace(t float64) SoA[T] {
+	scaleComponents(s.X, t)
+	scaleComponents(s.Y, t)
+	scaleComponents(s.Z, t)
+	return s
+}
+
+func (s SoA[T]) DivByConstant(t float64) SoA[T] {
+	out := s.clone()
+	for i := range out.X {
+		out.X[i] = T(float64(out.X[i]) / t)
+		out.Y[i] = T(float64(out.Y[i]) / t)
+		out.Z[i] = T(float64(out.Z[i]) / t)
+	}
+	return out
+}
+
+func (s SoA[T]) Normalized() SoA[T] {
+	out := s.clone()
+	for i := range out.X {
+		x, y, z := float64(out.X[i]), float64(out.Y[i]), float64(out.Z[i])
+		length := math.Sqrt(x*x + y*y + z*z)
+		out.X[i] = T(x / length)
+		out.Y[i] = T(y / length)
+		out.Z[i] = T(z / length)
+	}
+	return out
+}
+
+func (s SoA[T]) ContainsNaN() bool {
+	return containsNaN(s.X) || containsNaN(s.Y) || containsNaN(s.Z)
+}
+
+func (s SoA[T]) MaxLength() float64 {
+	maxSquared := 0.
+	for i := range s.X {
+		x, y, z := float64(s.X[i]), float64(s.Y[i]), float64(s.Z[i])
+		maxSquared = math.Max(maxSquared, x*x+y*y+z*z)
+	}
+	return math.Sqrt(maxSquared)
+}
+
+func (s SoA[T]) Sum() Vector[T] {
+	return Vector[T]{
+		x: sumComponents(s.X),
+		y: sumComponents(s.Y),
+		z: sumComponents(s.Z),
+	}
+}
+
+func (s SoA[T]) Modify(f func(Vector[T]) Vector[T]) SoA[T] {
+	out := NewSoA[T](s.Len())
+	for i := range s.X {
+		out.Set(i, f(s.At(i)))
+	}
+	return out
+}
+
+// Average sums all vector3's components together and divides each
+// component by the number of values added
+func (s SoA[T]) Average() Vector[float64] {
+	n := float64(s.Len())
+	return Vector[float64]{
+		x: sumComponentsFloat64(s.X) / n,
+		y: sumComponentsFloat64(s.Y) / n,
+		z: sumComponentsFloat64(s.Z) / n,
+	}
+}
+
+// Bounds returns the min and max points of an AABB encompassing
+func (s SoA[T]) Bounds() (Vector[T], Vector[T]) {
+	if s.Len() == 0 {
+		panic(errors.New("can not compute bounds from 0 vector elements"))
+	}
+
+	minX, maxX := componentBounds(s.X)
+	minY, maxY := componentBounds(s.Y)
+	minZ, maxZ := componentBounds(s.Z)
+	return Vector[T]{x: minX, y: minY, z: minZ}, Vector[T]{x: maxX, y: maxY, z: maxZ}
+}
+
+// StandardDeviation calculates the population standard deviation on each
+// component of the vector
+func (s SoA[T]) StandardDeviation() (mean, deviation Vector[float64]) {
+	mean = s.Average()
+	deviation = Vector[float64]{
+		x: componentDeviation(s.X, mean.x),
+		y: componentDeviation(s.Y, mean.y),
+		z: componentDeviation(s.Z, mean.z),
+	}
+	return
+}
+
+func (s SoA[T]) clone() SoA[T] {
+	return SoA[T]{
+		X: append([]T(nil), s.X...),
+		Y: append([]T(nil), s.Y...),
+		Z: append([]T(nil), s.Z...),
+	}
+}
+
+func addScalar[T vector.Number](component []T, v T) {
+	for i := range component {
+		component[i] += v
+	}
+}
+
+func scaleComponents[T vector.Number](component []T, t float64) {
+	for i, v := range component {
+		component[i] = T(float64(v) * t)
+	}
+}
+
+func containsNaN[T vector.Number](component []T) bool {
+	for _, v := range component {
+		if math.IsNaN(float64(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+func sumComponents[T vector.Number](component []T) (sum T) {
+	for _, v := range component {
+		sum += v
+	}
+	return
+}
+
+func sumComponentsFloat64[T vector.Number](component []T) (sum float64) {
+	for _, v := range component {
+		sum += float64(v)
+	}
+	return
+}
+
+func componentBounds[T vector.Number](component []T) (lo, hi T) {
+	lo, hi = component[0], component[0]
+	for _, v := range component[1:] {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	return
+}
+
+func componentDeviation[T vector.Number](component []T, mean float64) float64 {
+	total := 0.
+	for _, v := range component {
+		diff := float64(v) - mean
+		total += diff * diff
+	}
+	return math.Sqrt(total / float64(len(component)))
+}
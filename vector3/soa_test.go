@@ -0,0 +1,151 @@
+package vector3_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomArray(r *rand.Rand, n int) vector3.Float64Array {
+	arr := make(vector3.Float64Array, n)
+	for i := range arr {
+		arr[i] = vector3.RandRange(r, -10., 10.)
+	}
+	return arr
+}
+
+func assertArrayInDelta(t *testing.T, expected, actual vector3.Float64Array) {
+	t.Helper()
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.InDelta(t, expected[i].X(), actual[i].X(), 0.000001)
+		assert.InDelta(t, expected[i].Y(), actual[i].Y(), 0.000001)
+		assert.InDelta(t, expected[i].Z(), actual[i].Z(), 0.000001)
+	}
+}
+
+func TestSoA_RoundTrip(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+	assert.Equal(t, len(arr), soa.Len())
+	assert.Len(t, soa.X, len(arr))
+	assert.Len(t, soa.Y, len(arr))
+	assert.Len(t, soa.Z, len(arr))
+
+	for i, v := range arr {
+		assert.Equal(t, v, soa.At(i))
+		assert.Equal(t, v.X(), soa.X[i])
+		assert.Equal(t, v.Y(), soa.Y[i])
+		assert.Equal(t, v.Z(), soa.Z[i])
+	}
+	assert.Equal(t, arr, soa.ToArray())
+
+	soa.Set(3, vector3.New(1., 2., 3.))
+	assert.Equal(t, vector3.New(1., 2., 3.), soa.At(3))
+	assert.NotEqual(t, vector3.New(1., 2., 3.), arr[3])
+}
+
+func TestSoA_MatchesArray(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	other := vector3.New(1., -2., 3.)
+
+	tests := map[string]struct {
+		array func(vector3.Float64Array) vector3.Float64Array
+		soa   func(vector3.Float64SoA) vector3.Float64SoA
+	}{
+		"add": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.Add(other) },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.Add(other) },
+		},
+		"sub": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.Sub(other) },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.Sub(other) },
+		},
+		"scale": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.Scale(2.5) },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.Scale(2.5) },
+		},
+		"div by constant": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.DivByConstant(3) },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.DivByConstant(3) },
+		},
+		"normalized": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.Normalized() },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.Normalized() },
+		},
+		"modify": {
+			array: func(a vector3.Float64Array) vector3.Float64Array { return a.Modify(vector3.Float64.Abs) },
+			soa:   func(s vector3.Float64SoA) vector3.Float64SoA { return s.Modify(vector3.Float64.Abs) },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			soa := arr.ToSoA()
+			assertArrayInDelta(t, tc.array(arr), tc.soa(soa).ToArray())
+
+			// Source left untouched
+			assert.Equal(t, arr, soa.ToArray())
+		})
+	}
+}
+
+func TestSoA_InplaceMatchesArray(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := randomArray(r, 100)
+	soa := arr.ToSoA()
+
+	arr.AddInplace(vector3.New(1., 2., 3.)).ScaleInplace(0.5).SubInplace(vector3.New(4., 4., 4.))
+	out := soa.AddInplace(vector3.New(1., 2., 3.)).ScaleInplace(0.5).SubInplace(vector3.New(4., 4., 4.))
+
+	assertArrayInDelta(t, arr, soa.ToArray())
+	assertArrayInDelta(t, arr, out.ToArray())
+}
+
+func TestSoA_ReductionsMatchArray(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+
+	assert.InDelta(t, arr.Distance(), soa.Distance(), 0.000001)
+	assert.InDelta(t, arr.MaxLength(), soa.MaxLength(), 0.000001)
+
+	sum := arr.Sum()
+	soaSum := soa.Sum()
+	assert.InDelta(t, sum.X(), soaSum.X(), 0.000001)
+	assert.InDelta(t, sum.Y(), soaSum.Y(), 0.000001)
+	assert.InDelta(t, sum.Z(), soaSum.Z(), 0.000001)
+
+	mean, deviation := arr.StandardDeviation()
+	soaMean, soaDeviation := soa.StandardDeviation()
+	assert.InDelta(t, mean.X(), soaMean.X(), 0.000001)
+	assert.InDelta(t, mean.Y(), soaMean.Y(), 0.000001)
+	assert.InDelta(t, mean.Z(), soaMean.Z(), 0.000001)
+	assert.InDelta(t, deviation.X(), soaDeviation.X(), 0.000001)
+	assert.InDelta(t, deviation.Y(), soaDeviation.Y(), 0.000001)
+	assert.InDelta(t, deviation.Z(), soaDeviation.Z(), 0.000001)
+
+	average := soa.Average()
+	assert.InDelta(t, mean.X(), average.X(), 0.000001)
+
+	min, max := arr.Bounds()
+	soaMin, soaMax := soa.Bounds()
+	assert.Equal(t, min, soaMin)
+	assert.Equal(t, max, soaMax)
+}
+
+func TestSoA_ContainsNaN(t *testing.T) {
+	soa := vector3.NewSoA[float64](3)
+	assert.False(t, soa.ContainsNaN())
+
+	soa.Set(1, vector3.New(0., math.NaN(), 0.))
+	assert.True(t, soa.ContainsNaN())
+}
+
+func TestSoA_Bounds_PanicsOnZeroPoints(t *testing.T) {
+	assert.PanicsWithError(t, "can not compute bounds from 0 vector elements", func() {
+		vector3.NewSoA[float64](0).Bounds()
+	})
+}
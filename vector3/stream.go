@@ -0,0 +1,200 @@
+package vector3
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/EliCDavis/vector"
+)
+
+// StreamError is returned by Encoder and Decoder when writing or reading
+// fails, recording the offset of the vector being processed at the time
+type StreamError struct {
+	Offset int
+	Err    error
+}
+
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("vector %d: %v", e.Offset, e.Err)
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
+
+// streamChunk returns the number of vectors to process at a time, along with
+// the encoded size of a single vector
+func streamChunk[T vector.Number]() (perChunk, vectorSize int) {
+	_, size := componentType[T]()
+	vectorSize = size * componentCount
+	return arrayChunkSize / vectorSize, vectorSize
+}
+
+// growBuffer returns a slice of length n, reusing buf's memory when possible
+func growBuffer(buf []byte, n int) []byte {
+	if cap(buf) < n {
+		return make([]byte, n)
+	}
+	return buf[:n]
+}
+
+// Encoder writes vectors to an underlying writer one at a time or in chunks,
+// producing the same bytes as Write and Array.Write. A single buffer is reused
+// across every call to Encode and EncodeArray, and each call results in at
+// most one write per 64KiB of data, so wrapping the writer in a bufio.Writer
+// is recommended when encoding one vector at a time.
+//
+// Platform sized ints are encoded as int64.
+type Encoder[T vector.Number] struct {
+	out    io.Writer
+	endian binary.ByteOrder
+	buf    []byte
+	single Array[T]
+	offset int
+}
+
+// NewEncoder creates an encoder writing to out in the byte order provided.
+func NewEncoder[T vector.Number](out io.Writer, endian binary.ByteOrder) *Encoder[T] {
+	componentType[T]() // Fail fast on unsupported types
+	return &Encoder[T]{
+		out:    out,
+		endian: endian,
+		single: make(Array[T], 1),
+	}
+}
+
+// Offset returns the number of vectors successfully written so far
+func (e *Encoder[T]) Offset() int {
+	return e.offset
+}
+
+// Encode writes a single vector
+func (e *Encoder[T]) Encode(v Vector[T]) error {
+	_, vectorSize := streamChunk[T]()
+	e.single[0] = v
+	e.buf = growBuffer(e.buf, vectorSize)
+	encodeComponents(e.buf, e.single.Flat(), e.endian)
+
+	if _, err := e.out.Write(e.buf); err != nil {
+		return &StreamError{Offset: e.offset, Err: err}
+	}
+	e.offset++
+	return nil
+}
+
+// EncodeArray writes every vector in the array. When writing fails, the
+// returned StreamError records the offset of the first vector that was not
+// completely written.
+func (e *Encoder[T]) EncodeArray(arr Array[T]) error {
+	perChunk, vectorSize := streamChunk[T]()
+	direct := isDirect[T](e.endian)
+
+	for start := 0; start < len(arr); start += perChunk {
+		chunk := arr[start:min(start+perChunk, len(arr))]
+
+		var data []byte
+		if direct {
+			data = chunk.Bytes()
+		} else {
+			e.buf = growBuffer(e.buf, len(chunk)*vectorSize)
+			data = e.buf
+			encodeComponents(data, chunk.Flat(), e.endian)
+		}
+
+		n, err := e.out.Write(data)
+		if err != nil {
+			e.offset += n / vectorSize
+			return &StreamError{Offset: e.offset, Err: err}
+		}
+		e.offset += len(chunk)
+	}
+	return nil
+}
+
+// Decoder reads vectors from an underlying reader one at a time or in chunks,
+// accepting the same bytes as Read and ReadArray. A single buffer is reused
+// across every call to Decode and DecodeInto, so wrapping unbuffered readers
+// in a bufio.Reader is recommended when decoding one vector at a time.
+//
+// Platform sized ints are decoded from int64.
+type Decoder[T vector.Number] struct {
+	in     io.Reader
+	endian binary.ByteOrder
+	buf    []byte
+	single Array[T]
+	offset int
+}
+
+// NewDecoder creates a decoder reading from in with the byte order provided.
+func NewDecoder[T vector.Number](in io.Reader, endian binary.ByteOrder) *Decoder[T] {
+	componentType[T]() // Fail fast on unsupported types
+	return &Decoder[T]{
+		in:     in,
+		endian: endian,
+		single: make(Array[T], 1),
+	}
+}
+
+// Offset returns the number of vectors successfully read so far
+func (d *Decoder[T]) Offset() int {
+	return d.offset
+}
+
+// Decode reads a single vector. io.EOF is returned, unwrapped, once the
+// stream has ended cleanly between two vectors.
+func (d *Decoder[T]) Decode() (Vector[T], error) {
+	_, vectorSize := streamChunk[T]()
+	d.buf = growBuffer(d.buf, vectorSize)
+
+	if _, err := io.ReadFull(d.in, d.buf); err == io.EOF {
+		return Vector[T]{}, io.EOF
+	} else if err != nil {
+		return Vector[T]{}, &StreamError{Offset: d.offset, Err: err}
+	}
+
+	decodeComponents(d.single.Flat(), d.buf, d.endian)
+	d.offset++
+	return d.single[0], nil
+}
+
+// DecodeInto reads vectors into dst until it is full, returning the number
+// of vectors read. If the stream ends cleanly before dst is filled, the
+// number of vectors read is returned along with io.EOF, unwrapped. Any other
+// failure, including a stream ending partway through a vector, is returned
+// as a StreamError recording the offset of the vector that could not be
+// read. Only the first n vectors of dst are meaningful once an error occurs.
+func (d *Decoder[T]) DecodeInto(dst Array[T]) (int, error) {
+	perChunk, vectorSize := streamChunk[T]()
+	direct := isDirect[T](d.endian)
+
+	for start := 0; start < len(dst); start += perChunk {
+		chunk := dst[start:min(start+perChunk, len(dst))]
+
+		var data []byte
+		if direct {
+			data = chunk.Bytes()
+		} else {
+			d.buf = growBuffer(d.buf, len(chunk)*vectorSize)
+			data = d.buf
+		}
+
+		n, err := io.ReadFull(d.in, data)
+		complete := n / vectorSize
+		if !direct {
+			decodeComponents(chunk[:complete].Flat(), data[:complete*vectorSize], d.endian)
+		}
+		d.offset += complete
+
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, io.EOF) || (errors.Is(err, io.ErrUnexpectedEOF) && n%vectorSize == 0):
+			return start + complete, io.EOF
+		default:
+			return start + complete, &StreamError{Offset: d.offset, Err: err}
+		}
+	}
+	return len(dst), nil
+}
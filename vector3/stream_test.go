@@ -0,0 +1,216 @@
+package vector3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+type streamTestCase[T vector.Number] struct {
+	len int
+}
+
+func (tc streamTestCase[T]) test(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector3.Array[T], tc.len)
+	for i := range arr {
+		arr[i] = vector3.New(T(r.NormFloat64()*100), T(r.NormFloat64()*100), T(r.NormFloat64()*100))
+	}
+
+	for _, endian := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		expected := &bytes.Buffer{}
+		assert.NoError(t, arr.Write(expected, endian))
+
+		// One at a time
+		buf := &bytes.Buffer{}
+		enc := vector3.NewEncoder[T](buf, endian)
+		for _, v := range arr {
+			assert.NoError(t, enc.Encode(v))
+		}
+		assert.Equal(t, len(arr), enc.Offset())
+		assert.Equal(t, expected.Bytes(), buf.Bytes())
+
+		dec := vector3.NewDecoder[T](bytes.NewReader(buf.Bytes()), endian)
+		back := vector3.Array[T]{}
+		for {
+			v, err := dec.Decode()
+			if err != nil {
+				assert.Equal(t, io.EOF, err)
+				break
+			}
+			back = append(back, v)
+		}
+		assert.Equal(t, len(arr), dec.Offset())
+		assert.Equal(t, arr, back)
+
+		// In chunks
+		buf.Reset()
+		enc = vector3.NewEncoder[T](buf, endian)
+		for start := 0; start < len(arr); start += 7 {
+			assert.NoError(t, enc.EncodeArray(arr[start:min(start+7, len(arr))]))
+		}
+		assert.Equal(t, expected.Bytes(), buf.Bytes())
+
+		dec = vector3.NewDecoder[T](bytes.NewReader(buf.Bytes()), endian)
+		chunk := make(vector3.Array[T], 5)
+		back = vector3.Array[T]{}
+		for {
+			n, err := dec.DecodeInto(chunk)
+			back = append(back, chunk[:n]...)
+			if err != nil {
+				assert.Equal(t, io.EOF, err)
+				break
+			}
+		}
+		assert.Equal(t, arr, back)
+	}
+}
+
+func TestStream(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64":       streamTestCase[float64]{len: 100},
+		"float64 large": streamTestCase[float64]{len: 10_000},
+		"float32":       streamTestCase[float32]{len: 10_000},
+		"int8":          streamTestCase[int8]{len: 100},
+		"int16":         streamTestCase[int16]{len: 100},
+		"int32":         streamTestCase[int32]{len: 10_000},
+		"int64":         streamTestCase[int64]{len: 100},
+		"empty":         streamTestCase[float32]{len: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestStream_Int(t *testing.T) {
+	arr := vector3.IntArray{vector3.New(1, -2, 3), vector3.New(-1<<40, 5, 1<<50)}
+
+	buf := &bytes.Buffer{}
+	enc := vector3.NewEncoder[int](buf, binary.BigEndian)
+	assert.NoError(t, enc.EncodeArray(arr))
+
+	// Encoded as int64
+	asInt64, err := vector3.ReadArray[int64](bytes.NewReader(buf.Bytes()), binary.BigEndian, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.Int64Array{vector3.New[int64](1, -2, 3), vector3.New[int64](-1<<40, 5, 1<<50)}, asInt64)
+
+	dec := vector3.NewDecoder[int](buf, binary.BigEndian)
+	back := make(vector3.IntArray, 2)
+	n, err := dec.DecodeInto(back)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, arr, back)
+
+	assert.NoError(t, enc.Encode(arr[1]))
+	v, err := dec.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, arr[1], v)
+}
+
+func TestStream_ReusesBuffers(t *testing.T) {
+	v := vector3.New[float32](1, 2, 3)
+	data := bytes.Repeat([]byte{1}, 100*3*4)
+
+	enc := vector3.NewEncoder[float32](io.Discard, binary.BigEndian)
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		enc.Encode(v)
+	}))
+
+	dec := vector3.NewDecoder[float32](bytes.NewReader(data), binary.BigEndian)
+	assert.Zero(t, testing.AllocsPerRun(99, func() {
+		dec.Decode()
+	}))
+}
+
+func TestDecoder_Truncated(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := make(vector3.Float32Array, 10)
+	assert.NoError(t, arr.Write(buf, binary.BigEndian))
+
+	tests := map[string]struct {
+		data   []byte
+		read   int
+		offset int
+		err    error
+	}{
+		"clean end": {
+			data:   buf.Bytes(),
+			read:   10,
+			offset: 10,
+			err:    io.EOF,
+		},
+		"partial vector": {
+			data:   buf.Bytes()[:12*4+5],
+			read:   4,
+			offset: 4,
+			err:    &vector3.StreamError{Offset: 4, Err: io.ErrUnexpectedEOF},
+		},
+		"nothing": {
+			data: []byte{},
+			err:  io.EOF,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dec := vector3.NewDecoder[float32](bytes.NewReader(tc.data), binary.BigEndian)
+			n, err := dec.DecodeInto(make(vector3.Float32Array, 20))
+			assert.Equal(t, tc.read, n)
+			assert.Equal(t, tc.offset, dec.Offset())
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestDecoder_Decode_PartialVector(t *testing.T) {
+	dec := vector3.NewDecoder[float64](bytes.NewReader(make([]byte, 24+10)), binary.LittleEndian)
+
+	v, err := dec.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.Zero[float64](), v)
+
+	_, err = dec.Decode()
+	assert.EqualError(t, err, "vector 1: unexpected EOF")
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+// limitedWriter accepts a fixed number of bytes before failing
+type limitedWriter struct {
+	remaining int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) <= w.remaining {
+		w.remaining -= len(p)
+		return len(p), nil
+	}
+	n := w.remaining
+	w.remaining = 0
+	return n, errors.New("disk full")
+}
+
+func TestEncoder_Error(t *testing.T) {
+	arr := make(vector3.Float64Array, 10)
+	enc := vector3.NewEncoder[float64](&limitedWriter{remaining: 24*3 + 30}, binary.BigEndian)
+
+	assert.NoError(t, enc.EncodeArray(arr[:2]))
+	assert.NoError(t, enc.Encode(arr[2]))
+
+	err := enc.EncodeArray(arr[3:])
+	assert.EqualError(t, err, "vector 4: disk full")
+
+	var streamErr *vector3.StreamError
+	assert.True(t, errors.As(err, &streamErr))
+	assert.Equal(t, 4, streamErr.Offset)
+	assert.Equal(t, 4, enc.Offset())
+}
@@ -119,6 +119,48 @@ func LerpClamped[T vector.Number](a, b Vector[T], t float64) Vector[T] {
 	}
 }
 
+// Nlerp linearly interpolates between a and b by t, and then normalizes the
+// result. It's a cheaper approximation of Slerp for unit vectors.
+func Nlerp[T vector.Number](a, b Vector[T], t float64) Vector[T] {
+	return Lerp(a, b, t).Normalized()
+}
+
+// Slerp spherically interpolates between a and b by t. The direction is
+// rotated along the arc between the two vectors while the length is linearly
+// interpolated.
+func Slerp[T vector.Number](a, b Vector[T], t float64) Vector[T] {
+	af := a.ToFloat64()
+	bf := b.ToFloat64()
+	aLen := af.Length()
+	bLen := bf.Length()
+
+	if aLen < 1e-15 || bLen < 1e-15 {
+		return Lerp(a, b, t)
+	}
+
+	aDir := af.Scale(1. / aLen)
+	bDir := bf.Scale(1. / bLen)
+	theta := math.Acos(vector.Clamp(aDir.Dot(bDir), -1., 1.))
+
+	// Nearly parallel, the arc is indistinguishable from a line
+	if theta < 1e-6 {
+		return Lerp(a, b, t)
+	}
+
+	var dir Vector[float64]
+	if math.Pi-theta < 1e-6 {
+		// Opposite directions, any perpendicular axis is a valid arc
+		dir = aDir.RotateAroundAxis(aDir.Perpendicular(), theta*t)
+	} else {
+		sinTheta := math.Sin(theta)
+		dir = aDir.Scale(math.Sin((1-t)*theta) / sinTheta).
+			Add(bDir.Scale(math.Sin(t*theta) / sinTheta))
+	}
+
+	result := dir.Scale(aLen + (bLen-aLen)*t)
+	return New(T(result.x), T(result.y), T(result.z))
+}
+
 func Min[T vector.Number](a, b Vector[T]) Vector[T] {
 	return New(
 		T(math.Min(float64(a.x), float64(b.x))),
@@ -426,6 +468,77 @@ func (v Vector[T]) Perpendicular() Vector[T] {
 	return v.Cross(c)
 }
 
+// OrthonormalBasis builds two unit vectors that, together with this vector
+// normalized, form a right handed orthonormal basis. This is useful for
+// building a tangent frame from a single normal.
+//
+// Building an Orthonormal Basis, Revisited - Duff et al. 2017
+// https://jcgt.org/published/0006/01/01/
+func (v Vector[T]) OrthonormalBasis() (tangent, bitangent Vector[T]) {
+	n := v.ToFloat64().Normalized()
+	sign := math.Copysign(1, n.z)
+	a := -1. / (sign + n.z)
+	b := n.x * n.y * a
+
+	tangent = New(
+		T(1.+sign*n.x*n.x*a),
+		T(sign*b),
+		T(-sign*n.x),
+	)
+	bitangent = New(
+		T(b),
+		T(sign+n.y*n.y*a),
+		T(-n.y),
+	)
+	return
+}
+
+// Project returns the projection of this vector onto the vector passed in
+func (v Vector[T]) Project(onto Vector[T]) Vector[T] {
+	denominator := onto.LengthSquared()
+	if denominator < 1e-15 {
+		return Zero[T]()
+	}
+	return onto.Scale(v.Dot(onto) / denominator)
+}
+
+// Reject returns the component of this vector that is perpendicular to the
+// vector passed in, such that v = v.Project(o) + v.Reject(o)
+func (v Vector[T]) Reject(onto Vector[T]) Vector[T] {
+	return v.Sub(v.Project(onto))
+}
+
+// ProjectOnPlane projects this vector onto the plane passing through the
+// origin that is defined by the normal passed in
+func (v Vector[T]) ProjectOnPlane(normal Vector[T]) Vector[T] {
+	return v.Reject(normal)
+}
+
+// GramSchmidt builds an orthonormal set of vectors spanning the same space as
+// the vectors passed in. Vectors that are linearly dependent on the ones
+// before them contribute nothing and are left out of the result.
+func GramSchmidt[T vector.Number](vectors []Vector[T]) []Vector[T] {
+	basis := make([]Vector[float64], 0, 3)
+	for _, v := range vectors {
+		u := v.ToFloat64()
+		for _, b := range basis {
+			u = u.Sub(b.Scale(u.Dot(b)))
+		}
+
+		length := u.Length()
+		if length < 1e-10 {
+			continue
+		}
+		basis = append(basis, u.Scale(1./length))
+	}
+
+	out := make([]Vector[T], len(basis))
+	for i, b := range basis {
+		out[i] = New(T(b.x), T(b.y), T(b.z))
+	}
+	return out
+}
+
 // Round takes each component of the vector and rounds it to the nearest whole
 // number
 func (v Vector[T]) Round() Vector[T] {
@@ -701,6 +814,69 @@ func (v Vector[T]) Angle(other Vector[T]) float64 {
 	return math.Acos(vector.Clamp(v.Dot(other)/denominator, -1., 1.))
 }
 
+// SignedAngle returns the angle in radians between this vector and other,
+// which is negative when the rotation from this vector to other is clockwise
+// when looking down the axis provided
+func (v Vector[T]) SignedAngle(other, axis Vector[T]) float64 {
+	angle := v.Angle(other)
+	if v.ToFloat64().Cross(other.ToFloat64()).Dot(axis.ToFloat64()) < 0 {
+		return -angle
+	}
+	return angle
+}
+
+// RotateAroundAxis rotates the vector counter clockwise around the axis by
+// the angle provided in radians, using Rodrigues' rotation formula
+func (v Vector[T]) RotateAroundAxis(axis Vector[T], angle float64) Vector[T] {
+	k := axis.ToFloat64().Normalized()
+	vf := v.ToFloat64()
+	cos := math.Cos(angle)
+	sin := math.Sin(angle)
+
+	rotated := vf.Scale(cos).
+		Add(k.Cross(vf).Scale(sin)).
+		Add(k.Scale(k.Dot(vf) * (1 - cos)))
+
+	return New(T(rotated.x), T(rotated.y), T(rotated.z))
+}
+
+// RotateTowards rotates this vector towards the target, changing direction by
+// at most maxRadians and length by at most maxMagnitude.
+func (v Vector[T]) RotateTowards(target Vector[T], maxRadians, maxMagnitude float64) Vector[T] {
+	vf := v.ToFloat64()
+	tf := target.ToFloat64()
+	vLen := vf.Length()
+	tLen := tf.Length()
+
+	// No direction to rotate from or to, so just move towards the target
+	if vLen < 1e-15 || tLen < 1e-15 {
+		diff := tf.Sub(vf)
+		dist := diff.Length()
+		if dist <= maxMagnitude || dist == 0 {
+			return target
+		}
+		moved := vf.Add(diff.Scale(maxMagnitude / dist))
+		return New(T(moved.x), T(moved.y), T(moved.z))
+	}
+
+	newLen := vLen + vector.Clamp(tLen-vLen, -maxMagnitude, maxMagnitude)
+
+	angle := vf.Angle(tf)
+	var dir Vector[float64]
+	if angle <= maxRadians {
+		dir = tf.Scale(1. / tLen)
+	} else {
+		axis := vf.Cross(tf)
+		if axis.LengthSquared() < 1e-20 {
+			axis = vf.Perpendicular()
+		}
+		dir = vf.Scale(1./vLen).RotateAroundAxis(axis, maxRadians)
+	}
+
+	result := dir.Scale(newLen)
+	return New(T(result.x), T(result.y), T(result.z))
+}
+
 func (v Vector[T]) NearZero() bool {
 	const s = 1e-8
 	return (math.Abs(float64(v.x)) < s) && (math.Abs(float64(v.y)) < s) && (math.Abs(float64(v.z)) < s)
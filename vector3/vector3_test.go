@@ -549,6 +549,340 @@ func TestAngle(t *testing.T) {
 	}
 }
 
+func TestSignedAngle(t *testing.T) {
+	tests := map[string]struct {
+		a     vector3.Float64
+		b     vector3.Float64
+		axis  vector3.Float64
+		angle float64
+	}{
+		"right => forward around up": {
+			a:     vector3.Right[float64](),
+			b:     vector3.Forward[float64](),
+			axis:  vector3.Up[float64](),
+			angle: -math.Pi / 2,
+		},
+		"forward => right around up": {
+			a:     vector3.Forward[float64](),
+			b:     vector3.Right[float64](),
+			axis:  vector3.Up[float64](),
+			angle: math.Pi / 2,
+		},
+		"right => up around forward": {
+			a:     vector3.Right[float64](),
+			b:     vector3.Up[float64](),
+			axis:  vector3.Forward[float64](),
+			angle: math.Pi / 2,
+		},
+		"up => up: 0": {
+			a:     vector3.Up[float64](),
+			b:     vector3.Up[float64](),
+			axis:  vector3.Forward[float64](),
+			angle: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.angle, tc.a.SignedAngle(tc.b, tc.axis), 0.000001)
+		})
+	}
+}
+
+func TestRotateAroundAxis(t *testing.T) {
+	tests := map[string]struct {
+		v     vector3.Float64
+		axis  vector3.Float64
+		angle float64
+		want  vector3.Float64
+	}{
+		"right around up 90": {
+			v:     vector3.Right[float64](),
+			axis:  vector3.Up[float64](),
+			angle: math.Pi / 2,
+			want:  vector3.Backwards[float64](),
+		},
+		"right around forward 90": {
+			v:     vector3.Right[float64](),
+			axis:  vector3.Forward[float64](),
+			angle: math.Pi / 2,
+			want:  vector3.Up[float64](),
+		},
+		"unnormalized axis": {
+			v:     vector3.New(2., 0., 0.),
+			axis:  vector3.New(0., 0., 5.),
+			angle: math.Pi,
+			want:  vector3.New(-2., 0., 0.),
+		},
+		"parallel to axis": {
+			v:     vector3.New(0., 3., 0.),
+			axis:  vector3.Up[float64](),
+			angle: 1.234,
+			want:  vector3.New(0., 3., 0.),
+		},
+		"diagonal axis 120": {
+			v:     vector3.Right[float64](),
+			axis:  vector3.One[float64](),
+			angle: 2 * math.Pi / 3,
+			want:  vector3.Up[float64](),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.RotateAroundAxis(tc.axis, tc.angle)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+			assert.InDelta(t, tc.want.Z(), got.Z(), 0.000001)
+		})
+	}
+}
+
+func TestRotateTowards(t *testing.T) {
+	tests := map[string]struct {
+		v            vector3.Float64
+		target       vector3.Float64
+		maxRadians   float64
+		maxMagnitude float64
+		want         vector3.Float64
+	}{
+		"reaches target": {
+			v:            vector3.Right[float64](),
+			target:       vector3.Up[float64](),
+			maxRadians:   math.Pi,
+			maxMagnitude: 1,
+			want:         vector3.Up[float64](),
+		},
+		"limited angle": {
+			v:            vector3.Right[float64](),
+			target:       vector3.Up[float64](),
+			maxRadians:   math.Pi / 4,
+			maxMagnitude: 1,
+			want:         vector3.New(math.Sqrt2/2, math.Sqrt2/2, 0),
+		},
+		"limited magnitude": {
+			v:            vector3.Right[float64](),
+			target:       vector3.New(0., 10., 0.),
+			maxRadians:   math.Pi,
+			maxMagnitude: 2,
+			want:         vector3.New(0., 3., 0.),
+		},
+		"from zero": {
+			v:            vector3.Zero[float64](),
+			target:       vector3.New(0., 0., 4.),
+			maxRadians:   0,
+			maxMagnitude: 1,
+			want:         vector3.New(0., 0., 1.),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.v.RotateTowards(tc.target, tc.maxRadians, tc.maxMagnitude)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+			assert.InDelta(t, tc.want.Z(), got.Z(), 0.000001)
+		})
+	}
+}
+
+func TestRotateTowards_Opposite(t *testing.T) {
+	got := vector3.Up[float64]().RotateTowards(vector3.Down[float64](), math.Pi/2, 0)
+	assert.InDelta(t, 1, got.Length(), 0.000001)
+	assert.InDelta(t, math.Pi/2, got.Angle(vector3.Up[float64]()), 0.000001)
+	assert.InDelta(t, math.Pi/2, got.Angle(vector3.Down[float64]()), 0.000001)
+}
+
+func TestSlerp(t *testing.T) {
+	tests := map[string]struct {
+		a    vector3.Float64
+		b    vector3.Float64
+		t    float64
+		want vector3.Float64
+	}{
+		"start": {
+			a: vector3.Right[float64](), b: vector3.Up[float64](), t: 0,
+			want: vector3.Right[float64](),
+		},
+		"end": {
+			a: vector3.Right[float64](), b: vector3.Up[float64](), t: 1,
+			want: vector3.Up[float64](),
+		},
+		"halfway": {
+			a: vector3.Right[float64](), b: vector3.Up[float64](), t: 0.5,
+			want: vector3.New(math.Sqrt2/2, math.Sqrt2/2, 0),
+		},
+		"interpolates length": {
+			a: vector3.New(2., 0., 0.), b: vector3.New(0., 4., 0.), t: 0.5,
+			want: vector3.New(3*math.Sqrt2/2, 3*math.Sqrt2/2, 0),
+		},
+		"parallel": {
+			a: vector3.New(1., 0., 0.), b: vector3.New(3., 0., 0.), t: 0.5,
+			want: vector3.New(2., 0., 0.),
+		},
+		"from zero": {
+			a: vector3.Zero[float64](), b: vector3.New(0., 4., 0.), t: 0.25,
+			want: vector3.New(0., 1., 0.),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := vector3.Slerp(tc.a, tc.b, tc.t)
+			assert.InDelta(t, tc.want.X(), got.X(), 0.000001)
+			assert.InDelta(t, tc.want.Y(), got.Y(), 0.000001)
+			assert.InDelta(t, tc.want.Z(), got.Z(), 0.000001)
+		})
+	}
+}
+
+func TestSlerp_Opposite(t *testing.T) {
+	a := vector3.Right[float64]()
+	b := vector3.Left[float64]()
+
+	half := vector3.Slerp(a, b, 0.5)
+	assert.InDelta(t, 1, half.Length(), 0.000001)
+	assert.InDelta(t, math.Pi/2, half.Angle(a), 0.000001)
+	assert.InDelta(t, math.Pi/2, half.Angle(b), 0.000001)
+
+	end := vector3.Slerp(a, b, 1)
+	assert.InDelta(t, b.X(), end.X(), 0.000001)
+	assert.InDelta(t, b.Y(), end.Y(), 0.000001)
+	assert.InDelta(t, b.Z(), end.Z(), 0.000001)
+}
+
+func TestNlerp(t *testing.T) {
+	got := vector3.Nlerp(vector3.New(2., 0., 0.), vector3.New(0., 2., 0.), 0.5)
+	assert.InDelta(t, math.Sqrt2/2, got.X(), 0.000001)
+	assert.InDelta(t, math.Sqrt2/2, got.Y(), 0.000001)
+	assert.InDelta(t, 0, got.Z(), 0.000001)
+}
+
+func TestProjection(t *testing.T) {
+	tests := map[string]struct {
+		v       vector3.Float64
+		onto    vector3.Float64
+		project vector3.Float64
+		reject  vector3.Float64
+	}{
+		"onto x axis": {
+			v:       vector3.New(3., 4., 5.),
+			onto:    vector3.Right[float64](),
+			project: vector3.New(3., 0., 0.),
+			reject:  vector3.New(0., 4., 5.),
+		},
+		"onto unnormalized": {
+			v:       vector3.New(3., 4., 5.),
+			onto:    vector3.New(0., 10., 0.),
+			project: vector3.New(0., 4., 0.),
+			reject:  vector3.New(3., 0., 5.),
+		},
+		"onto diagonal": {
+			v:       vector3.New(2., 0., 0.),
+			onto:    vector3.New(1., 1., 0.),
+			project: vector3.New(1., 1., 0.),
+			reject:  vector3.New(1., -1., 0.),
+		},
+		"onto zero": {
+			v:       vector3.New(3., 4., 5.),
+			onto:    vector3.Zero[float64](),
+			project: vector3.Zero[float64](),
+			reject:  vector3.New(3., 4., 5.),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			project := tc.v.Project(tc.onto)
+			assert.InDelta(t, tc.project.X(), project.X(), 0.000001)
+			assert.InDelta(t, tc.project.Y(), project.Y(), 0.000001)
+			assert.InDelta(t, tc.project.Z(), project.Z(), 0.000001)
+
+			reject := tc.v.Reject(tc.onto)
+			assert.InDelta(t, tc.reject.X(), reject.X(), 0.000001)
+			assert.InDelta(t, tc.reject.Y(), reject.Y(), 0.000001)
+			assert.InDelta(t, tc.reject.Z(), reject.Z(), 0.000001)
+		})
+	}
+}
+
+func TestProjectOnPlane(t *testing.T) {
+	got := vector3.New(3., 4., 5.).ProjectOnPlane(vector3.New(0., 2., 0.))
+	assert.InDelta(t, 3, got.X(), 0.000001)
+	assert.InDelta(t, 0, got.Y(), 0.000001)
+	assert.InDelta(t, 5, got.Z(), 0.000001)
+}
+
+func TestOrthonormalBasis(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	normals := []vector3.Float64{
+		vector3.Up[float64](),
+		vector3.Down[float64](),
+		vector3.Forward[float64](),
+		vector3.Backwards[float64](),
+		vector3.Right[float64](),
+		vector3.New(0., 0., -0.),
+		vector3.New(1e-9, -1e-9, -1.),
+		vector3.New(3., -4., 12.),
+	}
+	for i := 0; i < 100; i++ {
+		normals = append(normals, vector3.RandNormal(r))
+	}
+
+	for _, n := range normals {
+		n = n.Normalized()
+		if n.ContainsNaN() {
+			continue
+		}
+		tangent, bitangent := n.OrthonormalBasis()
+
+		assert.InDelta(t, 1, tangent.Length(), 0.000001)
+		assert.InDelta(t, 1, bitangent.Length(), 0.000001)
+		assert.InDelta(t, 0, tangent.Dot(bitangent), 0.000001)
+		assert.InDelta(t, 0, tangent.Dot(n), 0.000001)
+		assert.InDelta(t, 0, bitangent.Dot(n), 0.000001)
+
+		// Right handed
+		cross := tangent.Cross(bitangent)
+		assert.InDelta(t, n.X(), cross.X(), 0.000001)
+		assert.InDelta(t, n.Y(), cross.Y(), 0.000001)
+		assert.InDelta(t, n.Z(), cross.Z(), 0.000001)
+	}
+}
+
+func TestGramSchmidt(t *testing.T) {
+	basis := vector3.GramSchmidt([]vector3.Float64{
+		vector3.New(1., 1., 0.),
+		vector3.New(2., 2., 0.), // dependent on the first
+		vector3.New(1., 0., 0.),
+		vector3.New(3., 2., 7.),
+		vector3.New(0., 0., 1.), // dependent on all the rest
+	})
+
+	assert.Len(t, basis, 3)
+	for i, a := range basis {
+		assert.InDelta(t, 1, a.Length(), 0.000001)
+		for j, b := range basis {
+			if i != j {
+				assert.InDelta(t, 0, a.Dot(b), 0.000001)
+			}
+		}
+	}
+
+	assert.InDelta(t, math.Sqrt2/2, basis[0].X(), 0.000001)
+	assert.InDelta(t, math.Sqrt2/2, basis[0].Y(), 0.000001)
+	assert.InDelta(t, 0, basis[0].Z(), 0.000001)
+
+	assert.InDelta(t, math.Sqrt2/2, basis[1].X(), 0.000001)
+	assert.InDelta(t, -math.Sqrt2/2, basis[1].Y(), 0.000001)
+	assert.InDelta(t, 0, basis[1].Z(), 0.000001)
+
+	assert.InDelta(t, 0, basis[2].X(), 0.000001)
+	assert.InDelta(t, 0, basis[2].Y(), 0.000001)
+	assert.InDelta(t, 1, basis[2].Z(), 0.000001)
+}
+
 func TestMaxComponent(t *testing.T) {
 	assert.Equal(t, 4., vector3.New(-2., 3., 4.).MaxComponent())
 }
@@ -0,0 +1,88 @@
+package vector3_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/EliCDavis/vector/vector3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_Flat(t *testing.T) {
+	arr := vector3.Float32Array{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](4, 5, 6),
+	}
+
+	flat := arr.Flat()
+	assert.Equal(t, []float32{1, 2, 3, 4, 5, 6}, flat)
+
+	// Shares memory in both directions
+	flat[4] = 50
+	assert.Equal(t, vector3.New[float32](4, 50, 6), arr[1])
+	arr[0] = vector3.New[float32](7, 8, 9)
+	assert.Equal(t, []float32{7, 8, 9}, flat[:3])
+
+	assert.Len(t, vector3.Float32Array{}.Flat(), 0)
+}
+
+func TestArrayFromFlat(t *testing.T) {
+	data := []int16{1, 2, 3, 4, 5, 6}
+	arr, err := vector3.ArrayFromFlat(data)
+	assert.NoError(t, err)
+	assert.Equal(t, vector3.Array[int16]{vector3.New[int16](1, 2, 3), vector3.New[int16](4, 5, 6)}, arr)
+
+	arr[1] = arr[1].SetX(40)
+	assert.Equal(t, int16(40), data[3])
+
+	_, err = vector3.ArrayFromFlat([]float64{1, 2, 3, 4})
+	assert.EqualError(t, err, "flat data length 4 is not a multiple of 3")
+
+	empty, err := vector3.ArrayFromFlat([]float64{})
+	assert.NoError(t, err)
+	assert.Len(t, empty, 0)
+}
+
+func TestArray_Bytes(t *testing.T) {
+	arr := vector3.Float32Array{
+		vector3.New[float32](1, 2, 3),
+		vector3.New[float32](4, 5, 6),
+	}
+
+	bytes := arr.Bytes()
+	assert.Len(t, bytes, 24)
+	for i, component := range arr.Flat() {
+		assert.Equal(t, component, math.Float32frombits(binary.NativeEndian.Uint32(bytes[i*4:])))
+	}
+
+	back, err := vector3.ArrayFromBytes[float32](bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+
+	back[0] = vector3.New[float32](-1, -2, -3)
+	assert.Equal(t, vector3.New[float32](-1, -2, -3), arr[0])
+}
+
+func TestArrayFromBytes_Errors(t *testing.T) {
+	_, err := vector3.ArrayFromBytes[float64](make([]byte, 30))
+	assert.EqualError(t, err, "byte data length 30 is not a multiple of the vector size 24")
+
+	// Offset a float64 aligned buffer by a single byte
+	buf := make([]uint64, 4)
+	misaligned := unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), 32)[1:25]
+	_, err = vector3.ArrayFromBytes[float64](misaligned)
+	assert.EqualError(t, err, "byte data is not aligned to 8 bytes")
+
+	arr, err := vector3.ArrayFromBytes[float64](nil)
+	assert.NoError(t, err)
+	assert.Len(t, arr, 0)
+}
+
+func TestVector_HasNoPadding(t *testing.T) {
+	assert.Equal(t, uintptr(3), unsafe.Sizeof(vector3.Int8{}))
+	assert.Equal(t, uintptr(6), unsafe.Sizeof(vector3.Int16{}))
+	assert.Equal(t, uintptr(12), unsafe.Sizeof(vector3.Float32{}))
+	assert.Equal(t, uintptr(24), unsafe.Sizeof(vector3.Float64{}))
+}
@@ -0,0 +1,233 @@
+package vector4
+
+import (
+	"errors"
+	"math"
+
+	"github.com/EliCDavis/vector"
+)
+
+type Array[T vector.Number] []Vector[T]
+
+type (
+	Float64Array = Array[float64]
+	Float32Array = Array[float32]
+	IntArray     = Array[int]
+	Int64Array   = Array[int64]
+	Int32Array   = Array[int32]
+	Int16Array   = Array[int16]
+	Int8Array    = Array[int8]
+)
+
+func (v4a Array[T]) Add(other Vector[T]) (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = Vector[T]{
+			v.x + other.x,
+			v.y + other.y,
+			v.z + other.z,
+			v.w + other.w,
+		}
+	}
+
+	return
+}
+
+func (v4a Array[T]) AddInplace(other Vector[T]) Array[T] {
+	for i, v := range v4a {
+		v4a[i] = Vector[T]{
+			v.x + other.x,
+			v.y + other.y,
+			v.z + other.z,
+			v.w + other.w,
+		}
+	}
+	return v4a
+}
+
+func (v4a Array[T]) Sub(other Vector[T]) (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = Vector[T]{
+			v.x - other.x,
+			v.y - other.y,
+			v.z - other.z,
+			v.w - other.w,
+		}
+	}
+
+	return
+}
+
+func (v4a Array[T]) SubInplace(other Vector[T]) Array[T] {
+	for i, v := range v4a {
+		v4a[i] = Vector[T]{
+			v.x - other.x,
+			v.y - other.y,
+			v.z - other.z,
+			v.w - other.w,
+		}
+	}
+	return v4a
+}
+
+func (v4a Array[T]) Distance() (total float64) {
+	if len(v4a) < 2 {
+		return
+	}
+	for i := 1; i < len(v4a); i++ {
+		total += v4a[i].Distance(v4a[i-1])
+	}
+	return
+}
+
+func (v4a Array[T]) Scale(t float64) (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = Vector[T]{
+			x: T(float64(v.x) * t),
+			y: T(float64(v.y) * t),
+			z: T(float64(v.z) * t),
+			w: T(float64(v.w) * t),
+		}
+	}
+
+	return
+}
+
+func (v4a Array[T]) ScaleInplace(t float64) Array[T] {
+	for i, v := range v4a {
+		v4a[i] = Vector[T]{
+			x: T(float64(v.x) * t),
+			y: T(float64(v.y) * t),
+			z: T(float64(v.z) * t),
+			w: T(float64(v.w) * t),
+		}
+	}
+	return v4a
+}
+
+func (v4a Array[T]) DivByConstant(t float64) (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = v.DivByConstant(t)
+	}
+
+	return
+}
+
+func (v4a Array[T]) Normalized() (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = v.Normalized()
+	}
+
+	return
+}
+
+func (v4a Array[T]) ContainsNaN() bool {
+	for _, v := range v4a {
+		if v.ContainsNaN() {
+			return true
+		}
+	}
+	return false
+}
+
+func (v4a Array[T]) MaxLength() float64 {
+	max := 0.
+
+	for _, v := range v4a {
+		max = math.Max(max, v.Length())
+	}
+
+	return max
+}
+
+func (v4a Array[T]) Sum() (sum Vector[T]) {
+	for _, v := range v4a {
+		sum = sum.Add(v)
+	}
+	return
+}
+
+func (v4a Array[T]) Modify(f func(Vector[T]) Vector[T]) (out Array[T]) {
+	out = make(Array[T], len(v4a))
+
+	for i, v := range v4a {
+		out[i] = f(v)
+	}
+
+	return
+}
+
+// Average sums all vector4's components together and divides each
+// component by the number of values added
+func (v4a Array[T]) Average() Vector[float64] {
+	xTotal := 0.
+	yTotal := 0.
+	zTotal := 0.
+	wTotal := 0.
+
+	for _, v := range v4a {
+		xTotal += float64(v.x)
+		yTotal += float64(v.y)
+		zTotal += float64(v.z)
+		wTotal += float64(v.w)
+	}
+
+	return New(xTotal, yTotal, zTotal, wTotal).DivByConstant(float64(len(v4a)))
+}
+
+// Bounds returns the min and max points of an AABB encompassing
+func (v4a Array[T]) Bounds() (Vector[T], Vector[T]) {
+	if len(v4a) == 0 {
+		panic(errors.New("can not compute bounds from 0 vector elements"))
+	}
+
+	minV := v4a[0]
+	maxV := v4a[0]
+
+	for i := 1; i < len(v4a); i++ {
+		v := v4a[i]
+		minV.x = min(minV.x, v.x)
+		minV.y = min(minV.y, v.y)
+		minV.z = min(minV.z, v.z)
+		minV.w = min(minV.w, v.w)
+
+		maxV.x = max(maxV.x, v.x)
+		maxV.y = max(maxV.y, v.y)
+		maxV.z = max(maxV.z, v.z)
+		maxV.w = max(maxV.w, v.w)
+	}
+
+	return minV, maxV
+}
+
+// StandardDeviation calculates the population standard deviation on each
+// component of the vector
+func (v4a Array[T]) StandardDeviation() (mean, deviation Vector[float64]) {
+	mean = v4a.Average()
+
+	xTotal, yTotal, zTotal, wTotal := 0., 0., 0., 0.
+	for _, v := range v4a {
+		diff := v.ToFloat64().Sub(mean)
+		xTotal += (diff.x * diff.x)
+		yTotal += (diff.y * diff.y)
+		zTotal += (diff.z * diff.z)
+		wTotal += (diff.w * diff.w)
+	}
+
+	deviation = New(
+		math.Sqrt(xTotal/float64(len(v4a))),
+		math.Sqrt(yTotal/float64(len(v4a))),
+		math.Sqrt(zTotal/float64(len(v4a))),
+		math.Sqrt(wTotal/float64(len(v4a))),
+	)
+	return
+}
@@ -0,0 +1,125 @@
+package vector4_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomArray(r *rand.Rand, n int) vector4.Float64Array {
+	arr := make(vector4.Float64Array, n)
+	for i := range arr {
+		arr[i] = vector4.New(
+			r.Float64()*20-10,
+			r.Float64()*20-10,
+			r.Float64()*20-10,
+			r.Float64()*20-10,
+		)
+	}
+	return arr
+}
+
+func TestArrayBounds(t *testing.T) {
+	pts := vector4.Float64Array{
+		vector4.New(-2., 0., 0., 1.),
+		vector4.New(-2., -4., 0., 7.),
+		vector4.New(3., 2., 0.5, -1.),
+		vector4.New(3., 1., 5., 0.),
+	}
+
+	min, max := pts.Bounds()
+	assert.Equal(t, vector4.New(-2., -4., 0., -1.), min)
+	assert.Equal(t, vector4.New(3., 2., 5., 7.), max)
+
+	assert.PanicsWithError(t, "can not compute bounds from 0 vector elements", func() {
+		vector4.Float64Array{}.Bounds()
+	})
+}
+
+func TestArrayDistance(t *testing.T) {
+	pts := vector4.Float64Array{
+		vector4.New(0., 0., 0., 0.),
+		vector4.New(0., 1., 0., 0.),
+		vector4.New(0., 1., 0., 2.),
+	}
+	assert.InDelta(t, 3, pts.Distance(), 0.000001)
+	assert.InDelta(t, 0, pts[:1].Distance(), 0.000001)
+}
+
+func TestArrayElementWise(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	other := vector4.New(1., -2., 3., -4.)
+
+	tests := map[string]struct {
+		array  func(vector4.Float64Array) vector4.Float64Array
+		vector func(vector4.Float64) vector4.Float64
+	}{
+		"add": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.Add(other) },
+			vector: func(v vector4.Float64) vector4.Float64 { return v.Add(other) },
+		},
+		"sub": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.Sub(other) },
+			vector: func(v vector4.Float64) vector4.Float64 { return v.Sub(other) },
+		},
+		"scale": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.Scale(2.5) },
+			vector: func(v vector4.Float64) vector4.Float64 { return v.Scale(2.5) },
+		},
+		"div by constant": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.DivByConstant(3) },
+			vector: func(v vector4.Float64) vector4.Float64 { return v.DivByConstant(3) },
+		},
+		"normalized": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.Normalized() },
+			vector: func(v vector4.Float64) vector4.Float64 { return v.Normalized() },
+		},
+		"modify": {
+			array:  func(a vector4.Float64Array) vector4.Float64Array { return a.Modify(vector4.Float64.Abs) },
+			vector: vector4.Float64.Abs,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := tc.array(arr)
+			assert.Len(t, out, len(arr))
+			for i, v := range arr {
+				assert.Equal(t, tc.vector(v), out[i])
+			}
+		})
+	}
+}
+
+func TestArrayInplace(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	want := arr.Add(vector4.One[float64]()).Scale(2).Sub(vector4.Fill(3.))
+
+	out := arr.AddInplace(vector4.One[float64]()).ScaleInplace(2).SubInplace(vector4.Fill(3.))
+	assert.Equal(t, want, arr)
+	assert.Equal(t, want, out)
+}
+
+func TestArrayReductions(t *testing.T) {
+	arr := vector4.Float64Array{
+		vector4.New(1., 2., 3., 4.),
+		vector4.New(3., 2., 1., 0.),
+		vector4.New(-1., 2., 5., 2.),
+	}
+
+	assert.Equal(t, vector4.New(3., 6., 9., 6.), arr.Sum())
+	assert.InDelta(t, math.Sqrt(34), arr.MaxLength(), 0.000001)
+	assert.False(t, arr.ContainsNaN())
+	assert.True(t, append(arr, vector4.New(0., 0., math.NaN(), 0.)).ContainsNaN())
+
+	mean, deviation := arr.StandardDeviation()
+	assert.Equal(t, vector4.New(1., 2., 3., 2.), mean)
+	assert.Equal(t, mean, arr.Average())
+	assert.InDelta(t, math.Sqrt(8./3.), deviation.X(), 0.000001)
+	assert.InDelta(t, 0, deviation.Y(), 0.000001)
+	assert.InDelta(t, math.Sqrt(8./3.), deviation.Z(), 0.000001)
+	assert.InDelta(t, math.Sqrt(8./3.), deviation.W(), 0.000001)
+}
@@ -0,0 +1,180 @@
+package vector4
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/EliCDavis/vector"
+)
+
+// writeDeltas writes the number of vectors followed by the difference
+// between each component and the same component of the previous vector, as
+// zigzag varints. Data is buffered and written in chunks.
+func writeDeltas(out io.Writer, count int, component func(i int) int64) error {
+	buf := make([]byte, 0, arrayChunkSize+binary.MaxVarintLen64)
+	buf = binary.AppendUvarint(buf, uint64(count))
+
+	var previous [componentCount]int64
+	for i := 0; i < count; i++ {
+		for c := range componentCount {
+			v := component(i*componentCount + c)
+			buf = binary.AppendVarint(buf, v-previous[c])
+			previous[c] = v
+		}
+
+		if len(buf) >= arrayChunkSize {
+			if _, err := out.Write(buf); err != nil {
+				return err
+			}
+			buf = buf[:0]
+		}
+	}
+
+	_, err := out.Write(buf)
+	return err
+}
+
+// byteReader returns in as an io.ByteReader, buffering it if required
+func byteReader(in io.Reader) io.ByteReader {
+	if br, ok := in.(io.ByteReader); ok {
+		return br
+	}
+	return bufio.NewReader(in)
+}
+
+// readDeltas reads data written by writeDeltas, passing each reconstructed
+// component to set
+func readDeltas[T vector.Number](in io.ByteReader, set func(dst []T, i int, v int64)) (Array[T], error) {
+	count, err := binary.ReadUvarint(in)
+	if err != nil {
+		return nil, err
+	}
+	if count > math.MaxInt/componentCount {
+		return nil, fmt.Errorf("delta encoded vector count %d is too large", count)
+	}
+
+	// Grow gradually rather than trusting the count with one huge
+	// allocation, in case the data is corrupt or truncated
+	const growBy = 1 << 16
+	out := make(Array[T], 0, min(int(count), growBy))
+
+	var previous [componentCount]int64
+	for len(out) < int(count) {
+		start := len(out)
+		n := min(int(count)-start, growBy)
+		out = slices.Grow(out, n)[:start+n]
+
+		flat := out[start:].Flat()
+		for i := range flat {
+			delta, err := binary.ReadVarint(in)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+			c := i % componentCount
+			previous[c] += delta
+			set(flat, i, previous[c])
+		}
+	}
+	return out, nil
+}
+
+// integerComponents panics if T isn't an integer type
+func integerComponents[T vector.Number]() {
+	var v T
+	switch any(v).(type) {
+	case int8, int16, int, int32, int64:
+		return
+	}
+	panic(fmt.Errorf("delta encoding requires integer components, not %T", v))
+}
+
+// WriteDelta compactly writes an array of integer vectors by storing the
+// difference between consecutive vectors as zigzag varints, prefixed by the
+// number of vectors. Arrays where consecutive vectors are close together,
+// like voxel coordinates or tracks, shrink considerably. Float arrays should
+// use WriteQuantizedDelta instead.
+func (v4a Array[T]) WriteDelta(out io.Writer) error {
+	integerComponents[T]()
+	flat := v4a.Flat()
+	return writeDeltas(out, len(v4a), func(i int) int64 {
+		return int64(flat[i])
+	})
+}
+
+// ReadDelta reads an array written by WriteDelta. If in isn't an
+// io.ByteReader it is buffered, which may consume data past the end of the
+// array.
+func ReadDelta[T vector.Number](in io.Reader) (Array[T], error) {
+	integerComponents[T]()
+	return readDeltas(byteReader(in), func(dst []T, i int, v int64) {
+		dst[i] = T(v)
+	})
+}
+
+// WriteQuantizedDelta snaps every component to the closest multiple of step
+// before writing the array in the same manner as WriteDelta, preceded by the
+// step itself. Components are reconstructed to within step / 2 of their
+// original values.
+func (v4a Array[T]) WriteQuantizedDelta(out io.Writer, step float64) error {
+	if !(step > 0) || math.IsInf(step, 1) {
+		panic(fmt.Errorf("invalid quantization step: %g", step))
+	}
+
+	flat := v4a.Flat()
+	quantized := make([]int64, len(flat))
+	for i, c := range flat {
+		q := math.Round(float64(c) / step)
+		if math.IsNaN(q) || math.Abs(q) > 1<<62 {
+			return fmt.Errorf("component %g can not be quantized with a step of %g", float64(c), step)
+		}
+		quantized[i] = int64(q)
+	}
+
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint64(header, math.Float64bits(step))
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+
+	return writeDeltas(out, len(v4a), func(i int) int64 {
+		return quantized[i]
+	})
+}
+
+// ReadQuantizedDelta reads an array written by WriteQuantizedDelta. Integer
+// components are rounded to the nearest integer. If in isn't an
+// io.ByteReader it is buffered, which may consume data past the end of the
+// array.
+func ReadQuantizedDelta[T vector.Number](in io.Reader) (Array[T], error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return nil, err
+	}
+	step := math.Float64frombits(binary.LittleEndian.Uint64(header))
+	if !(step > 0) || math.IsInf(step, 1) {
+		return nil, fmt.Errorf("invalid quantization step: %g", step)
+	}
+
+	var zero T
+	round := true
+	switch any(zero).(type) {
+	case float32, float64:
+		round = false
+	}
+
+	return readDeltas(byteReader(in), func(dst []T, i int, v int64) {
+		f := float64(v) * step
+		if round {
+			f = math.Round(f)
+		}
+		dst[i] = T(f)
+	})
+}
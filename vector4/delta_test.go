@@ -0,0 +1,212 @@
+package vector4_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+// readerOnly hides any other interfaces implemented by the reader
+type readerOnly struct {
+	io.Reader
+}
+
+// voxelWalk steps between neighboring voxels, like the output of a
+// rasterizer or flood fill
+func voxelWalk[T vector.Number](r *rand.Rand, n int) vector4.Array[T] {
+	out := make(vector4.Array[T], n)
+	current := vector4.Zero[T]()
+	for i := range out {
+		step := T(r.Intn(3) - 1)
+		switch r.Intn(4) {
+		case 0:
+			current = current.SetX(current.X() + step)
+		case 1:
+			current = current.SetY(current.Y() + step)
+		case 2:
+			current = current.SetZ(current.Z() + step)
+		case 3:
+			current = current.SetW(current.W() + step)
+		}
+		out[i] = current
+	}
+	return out
+}
+
+type deltaTestCase[T vector.Number] struct {
+	arr vector4.Array[T]
+}
+
+func (tc deltaTestCase[T]) test(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, tc.arr.WriteDelta(buf))
+	data := buf.Bytes()
+
+	back, err := vector4.ReadDelta[T](bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, tc.arr, back)
+
+	back, err = vector4.ReadDelta[T](readerOnly{bytes.NewReader(data)})
+	assert.NoError(t, err)
+	assert.Equal(t, tc.arr, back)
+
+	_, err = vector4.ReadDelta[T](bytes.NewReader(data[:len(data)-1]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestArrayReadWriteDelta(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	tests := map[string]testCaseI{
+		"int":   deltaTestCase[int]{arr: voxelWalk[int](r, 100_000)},
+		"int8":  deltaTestCase[int8]{arr: voxelWalk[int8](r, 100)},
+		"int16": deltaTestCase[int16]{arr: voxelWalk[int16](r, 1000)},
+		"int32": deltaTestCase[int32]{arr: voxelWalk[int32](r, 1000)},
+		"int64": deltaTestCase[int64]{arr: voxelWalk[int64](r, 1000)},
+		"extremes": deltaTestCase[int64]{arr: vector4.Int64Array{
+			vector4.New[int64](math.MinInt64, math.MaxInt64, 0, 1),
+			vector4.New[int64](math.MaxInt64, math.MinInt64, -1, math.MinInt64),
+			vector4.New[int64](math.MinInt64, 0, math.MaxInt64, math.MaxInt64),
+		}},
+		"int8 extremes": deltaTestCase[int8]{arr: vector4.Int8Array{
+			vector4.New[int8](math.MinInt8, math.MaxInt8, 0, math.MaxInt8),
+			vector4.New[int8](math.MaxInt8, math.MinInt8, -1, math.MinInt8),
+		}},
+		"single": deltaTestCase[int]{arr: vector4.IntArray{vector4.New(1, 2, 3, 4)}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestArrayWriteDelta_Empty(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, vector4.IntArray{}.WriteDelta(buf))
+	assert.Equal(t, []byte{0}, buf.Bytes())
+
+	back, err := vector4.ReadDelta[int](buf)
+	assert.NoError(t, err)
+	assert.Empty(t, back)
+
+	_, err = vector4.ReadDelta[int](buf)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestArrayWriteDelta_CompressionRatio(t *testing.T) {
+	voxels := voxelWalk[int](rand.New(rand.NewSource(42)), 100_000)
+
+	plain := &bytes.Buffer{}
+	assert.NoError(t, voxels.Write(plain, binary.LittleEndian))
+
+	voxels32 := make(vector4.Int32Array, len(voxels))
+	for i, v := range voxels {
+		voxels32[i] = vector4.New(int32(v.X()), int32(v.Y()), int32(v.Z()), int32(v.W()))
+	}
+	plain32 := &bytes.Buffer{}
+	assert.NoError(t, voxels32.Write(plain32, binary.LittleEndian))
+
+	delta := &bytes.Buffer{}
+	assert.NoError(t, voxels.WriteDelta(delta))
+
+	ratio := float64(plain.Len()) / float64(delta.Len())
+	ratio32 := float64(plain32.Len()) / float64(delta.Len())
+	t.Logf("voxels: plain %d bytes, plain int32 %d bytes, delta %d bytes (%.1fx, %.1fx)", plain.Len(), plain32.Len(), delta.Len(), ratio, ratio32)
+	assert.Greater(t, ratio, 7.5)
+	assert.Greater(t, ratio32, 3.75)
+}
+
+func TestArrayWriteQuantizedDelta(t *testing.T) {
+	// A GPS like track of (longitude, latitude, altitude, time)
+	r := rand.New(rand.NewSource(42))
+	track := make(vector4.Float64Array, 100_000)
+	current := vector4.New(-122.4194, 37.7749, 16., 0.)
+	for i := range track {
+		current = current.Add(vector4.New(r.NormFloat64()*1e-5, r.NormFloat64()*1e-5, r.NormFloat64()*0.1, 1))
+		track[i] = current
+	}
+
+	plain := &bytes.Buffer{}
+	assert.NoError(t, track.Write(plain, binary.LittleEndian))
+
+	const step = 1e-6
+	delta := &bytes.Buffer{}
+	assert.NoError(t, track.WriteQuantizedDelta(delta, step))
+
+	ratio := float64(plain.Len()) / float64(delta.Len())
+	t.Logf("track: plain %d bytes, quantized delta %d bytes (%.1fx)", plain.Len(), delta.Len(), ratio)
+	assert.Greater(t, ratio, 3.)
+
+	data := delta.Bytes()
+	back, err := vector4.ReadQuantizedDelta[float64](bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, back, len(track))
+	for i := range track {
+		if !assert.InDelta(t, track[i].X(), back[i].X(), step/2+1e-12) ||
+			!assert.InDelta(t, track[i].Y(), back[i].Y(), step/2+1e-12) ||
+			!assert.InDelta(t, track[i].Z(), back[i].Z(), step/2+1e-12) ||
+			!assert.InDelta(t, track[i].W(), back[i].W(), step/2+1e-12) {
+			return
+		}
+	}
+
+	back32, err := vector4.ReadQuantizedDelta[float32](readerOnly{bytes.NewReader(data)})
+	assert.NoError(t, err)
+	assert.Len(t, back32, len(back))
+	for i := range back {
+		assert.Equal(t, back[i].ToFloat32(), back32[i])
+	}
+
+	_, err = vector4.ReadQuantizedDelta[float64](bytes.NewReader(data[:len(data)-1]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = vector4.ReadQuantizedDelta[float64](bytes.NewReader(data[:4]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestArrayWriteQuantizedDelta_Integers(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := vector4.IntArray{vector4.New(10, 20, 31, 0), vector4.New(-15, 0, 44, 2)}
+	assert.NoError(t, arr.WriteQuantizedDelta(buf, 5))
+
+	back, err := vector4.ReadQuantizedDelta[int](buf)
+	assert.NoError(t, err)
+	assert.Equal(t, vector4.IntArray{vector4.New(10, 20, 30, 0), vector4.New(-15, 0, 45, 0)}, back)
+}
+
+func TestArrayWriteQuantizedDelta_Errors(t *testing.T) {
+	assert.PanicsWithError(t, "invalid quantization step: 0", func() {
+		vector4.Float64Array{}.WriteQuantizedDelta(&bytes.Buffer{}, 0)
+	})
+	assert.PanicsWithError(t, "invalid quantization step: NaN", func() {
+		vector4.Float64Array{}.WriteQuantizedDelta(&bytes.Buffer{}, math.NaN())
+	})
+
+	err := vector4.Float64Array{vector4.New(1, math.Inf(1), 0, 0)}.WriteQuantizedDelta(&bytes.Buffer{}, 0.1)
+	assert.EqualError(t, err, "component +Inf can not be quantized with a step of 0.1")
+
+	err = vector4.Float64Array{vector4.New(1, 2, math.NaN(), 0)}.WriteQuantizedDelta(&bytes.Buffer{}, 0.1)
+	assert.EqualError(t, err, "component NaN can not be quantized with a step of 0.1")
+
+	header := make([]byte, 9)
+	binary.LittleEndian.PutUint64(header, math.Float64bits(-1))
+	_, err = vector4.ReadQuantizedDelta[float64](bytes.NewReader(header))
+	assert.EqualError(t, err, "invalid quantization step: -1")
+}
+
+func TestArrayWriteDelta_FloatsPanic(t *testing.T) {
+	assert.PanicsWithError(t, "delta encoding requires integer components, not float64", func() {
+		vector4.Float64Array{}.WriteDelta(&bytes.Buffer{})
+	})
+	assert.PanicsWithError(t, "delta encoding requires integer components, not float32", func() {
+		vector4.ReadDelta[float32](&bytes.Buffer{})
+	})
+}
@@ -0,0 +1,80 @@
+package vector4
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/EliCDavis/vector"
+)
+
+// All returns an iterator over the index and value of every vector in the
+// array
+func (v4a Array[T]) All() iter.Seq2[int, Vector[T]] {
+	return func(yield func(int, Vector[T]) bool) {
+		for i, v := range v4a {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over every vector in the array
+func (v4a Array[T]) Values() iter.Seq[Vector[T]] {
+	return func(yield func(Vector[T]) bool) {
+		for _, v := range v4a {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Segments returns an iterator over each pair of consecutive vectors in the
+// array, treating it as a path
+func (v4a Array[T]) Segments() iter.Seq2[Vector[T], Vector[T]] {
+	return func(yield func(Vector[T], Vector[T]) bool) {
+		for i := 1; i < len(v4a); i++ {
+			if !yield(v4a[i-1], v4a[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Windows returns an iterator over every run of size consecutive vectors in
+// the array, stepping forward one vector at a time. Each window shares
+// memory with the array rather than being copied.
+func (v4a Array[T]) Windows(size int) iter.Seq[Array[T]] {
+	if size < 1 {
+		panic(fmt.Errorf("invalid window size: %d", size))
+	}
+	return func(yield func(Array[T]) bool) {
+		for i := size; i <= len(v4a); i++ {
+			if !yield(v4a[i-size : i : i]) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator that lazily applies f to every vector produced by
+// seq
+func Map[T, U vector.Number](seq iter.Seq[Vector[T]], f func(Vector[T]) Vector[U]) iter.Seq[Vector[U]] {
+	return func(yield func(Vector[U]) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers every vector produced by seq into a new array
+func Collect[T vector.Number](seq iter.Seq[Vector[T]]) Array[T] {
+	out := make(Array[T], 0)
+	for v := range seq {
+		out = append(out, v)
+	}
+	return out
+}
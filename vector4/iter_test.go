@@ -0,0 +1,39 @@
+package vector4_test
+
+import (
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_Iterators(t *testing.T) {
+	arr := vector4.IntArray{vector4.Fill(1), vector4.Fill(2), vector4.Fill(3)}
+
+	indices := make([]int, 0)
+	for i, v := range arr.All() {
+		indices = append(indices, i)
+		assert.Equal(t, arr[i], v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, arr, vector4.Collect(arr.Values()))
+
+	segments := 0
+	for a, b := range arr.Segments() {
+		assert.Equal(t, a.Add(vector4.One[int]()), b)
+		segments++
+	}
+	assert.Equal(t, 2, segments)
+
+	windows := make([]vector4.IntArray, 0)
+	for w := range arr.Windows(1) {
+		windows = append(windows, w)
+	}
+	assert.Equal(t, []vector4.IntArray{arr[0:1], arr[1:2], arr[2:3]}, windows)
+}
+
+func TestMap(t *testing.T) {
+	arr := vector4.Float64Array{vector4.New(1.2, -2.7, 3.5, 0.1)}
+	rounded := vector4.Collect(vector4.Map(arr.Values(), vector4.Float64.RoundToInt))
+	assert.Equal(t, vector4.IntArray{vector4.New(1, -3, 4, 0)}, rounded)
+}
@@ -0,0 +1,252 @@
+package vector4
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"unsafe"
+
+	"github.com/EliCDavis/vector"
+)
+
+// arrayHeaderSize is the size of the header written by WriteWithHeader: one
+// byte for the component type, one for the component count, and eight for
+// the number of vectors
+const arrayHeaderSize = 10
+
+// arrayChunkSize is the number of bytes encoded at a time when data can't be
+// written directly from memory
+const arrayChunkSize = 1 << 16
+
+// componentType returns the code identifying T within array headers, along
+// with the size of T in bytes once encoded. Platform sized ints are encoded
+// as int64 so that data is portable between 32 and 64 bit machines, but keep
+// a header code of their own.
+func componentType[T vector.Number]() (code byte, size int) {
+	var v T
+	switch any(v).(type) {
+	case int8:
+		return 1, 1
+	case int16:
+		return 2, 2
+	case int32:
+		return 3, 4
+	case int64:
+		return 4, 8
+	case float32:
+		return 5, 4
+	case float64:
+		return 6, 8
+	case int:
+		return 7, 8
+	}
+	panic(fmt.Errorf("unimplemented component type: %T", v))
+}
+
+// isDirect determines whether or not components of type T in the byte order
+// can be copied directly to and from memory
+func isDirect[T vector.Number](endian binary.ByteOrder) bool {
+	var v T
+	_, size := componentType[T]()
+	if int(unsafe.Sizeof(v)) != size {
+		return false
+	}
+	probe := []byte{1, 0}
+	return size == 1 || endian.Uint16(probe) == binary.NativeEndian.Uint16(probe)
+}
+
+func encodeComponents[T vector.Number](dst []byte, src []T, endian binary.ByteOrder) {
+	switch s := any(src).(type) {
+	case []int8:
+		for i, v := range s {
+			dst[i] = byte(v)
+		}
+	case []int16:
+		for i, v := range s {
+			endian.PutUint16(dst[i*2:], uint16(v))
+		}
+	case []int32:
+		for i, v := range s {
+			endian.PutUint32(dst[i*4:], uint32(v))
+		}
+	case []int64:
+		for i, v := range s {
+			endian.PutUint64(dst[i*8:], uint64(v))
+		}
+	case []int:
+		for i, v := range s {
+			endian.PutUint64(dst[i*8:], uint64(v))
+		}
+	case []float32:
+		for i, v := range s {
+			endian.PutUint32(dst[i*4:], math.Float32bits(v))
+		}
+	case []float64:
+		for i, v := range s {
+			endian.PutUint64(dst[i*8:], math.Float64bits(v))
+		}
+	default:
+		panic(fmt.Errorf("unimplemented component type: %T", src))
+	}
+}
+
+func decodeComponents[T vector.Number](dst []T, src []byte, endian binary.ByteOrder) {
+	switch d := any(dst).(type) {
+	case []int8:
+		for i := range d {
+			d[i] = int8(src[i])
+		}
+	case []int16:
+		for i := range d {
+			d[i] = int16(endian.Uint16(src[i*2:]))
+		}
+	case []int32:
+		for i := range d {
+			d[i] = int32(endian.Uint32(src[i*4:]))
+		}
+	case []int64:
+		for i := range d {
+			d[i] = int64(endian.Uint64(src[i*8:]))
+		}
+	case []int:
+		for i := range d {
+			d[i] = int(int64(endian.Uint64(src[i*8:])))
+		}
+	case []float32:
+		for i := range d {
+			d[i] = math.Float32frombits(endian.Uint32(src[i*4:]))
+		}
+	case []float64:
+		for i := range d {
+			d[i] = math.Float64frombits(endian.Uint64(src[i*8:]))
+		}
+	default:
+		panic(fmt.Errorf("unimplemented component type: %T", dst))
+	}
+}
+
+// Write writes every vector in the array to out. When the byte order matches
+// the machine's, the array's memory is written directly. Otherwise vectors
+// are encoded in chunks through a single reused buffer.
+func (v4a Array[T]) Write(out io.Writer, endian binary.ByteOrder) error {
+	_, size := componentType[T]()
+	if len(v4a) == 0 {
+		return nil
+	}
+
+	if isDirect[T](endian) {
+		_, err := out.Write(v4a.Bytes())
+		return err
+	}
+
+	flat := v4a.Flat()
+	perChunk := arrayChunkSize / size
+	buf := make([]byte, min(len(flat), perChunk)*size)
+	for start := 0; start < len(flat); start += perChunk {
+		components := flat[start:min(start+perChunk, len(flat))]
+		encodeComponents(buf, components, endian)
+		if _, err := out.Write(buf[:len(components)*size]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteWithHeader writes a header recording the component type and number of
+// vectors, followed by the vectors themselves. Arrays written this way can be
+// read back with ReadArrayWithHeader.
+func (v4a Array[T]) WriteWithHeader(out io.Writer, endian binary.ByteOrder) error {
+	code, _ := componentType[T]()
+	header := make([]byte, arrayHeaderSize)
+	header[0] = code
+	header[1] = componentCount
+	endian.PutUint64(header[2:], uint64(len(v4a)))
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+	return v4a.Write(out, endian)
+}
+
+// ReadArrayInto fills the entirety of the preallocated array with vectors
+// read from in
+func ReadArrayInto[T vector.Number](in io.Reader, endian binary.ByteOrder, dst Array[T]) error {
+	_, size := componentType[T]()
+	if len(dst) == 0 {
+		return nil
+	}
+
+	if isDirect[T](endian) {
+		_, err := io.ReadFull(in, dst.Bytes())
+		return err
+	}
+
+	flat := dst.Flat()
+	perChunk := arrayChunkSize / size
+	buf := make([]byte, min(len(flat), perChunk)*size)
+	for start := 0; start < len(flat); start += perChunk {
+		components := flat[start:min(start+perChunk, len(flat))]
+		chunk := buf[:len(components)*size]
+		if _, err := io.ReadFull(in, chunk); err != nil {
+			return err
+		}
+		decodeComponents(components, chunk, endian)
+	}
+	return nil
+}
+
+// ReadArray reads n vectors from in
+func ReadArray[T vector.Number](in io.Reader, endian binary.ByteOrder, n int) (Array[T], error) {
+	out := make(Array[T], n)
+	if err := ReadArrayInto(in, endian, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadArrayHeader reads a header written by WriteWithHeader, returning the
+// number of vectors that follow it. An error is returned if the header
+// describes vectors of a different type than T.
+func ReadArrayHeader[T vector.Number](in io.Reader, endian binary.ByteOrder) (int, error) {
+	header := make([]byte, arrayHeaderSize)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return 0, err
+	}
+
+	code, _ := componentType[T]()
+	if header[0] != code {
+		return 0, fmt.Errorf("array header component type %d does not match expected type %d", header[0], code)
+	}
+	if header[1] != componentCount {
+		return 0, fmt.Errorf("array header component count %d does not match expected count %d", header[1], componentCount)
+	}
+
+	count := endian.Uint64(header[2:])
+	if count > math.MaxInt {
+		return 0, fmt.Errorf("array header vector count %d is too large", count)
+	}
+	return int(count), nil
+}
+
+// ReadArrayWithHeader reads an array written by WriteWithHeader
+func ReadArrayWithHeader[T vector.Number](in io.Reader, endian binary.ByteOrder) (Array[T], error) {
+	count, err := ReadArrayHeader[T](in, endian)
+	if err != nil {
+		return nil, err
+	}
+
+	// Grow gradually rather than trusting the header with one huge
+	// allocation, in case the data is corrupt or truncated
+	const growBy = 1 << 16
+	out := make(Array[T], 0, min(count, growBy))
+	for len(out) < count {
+		start := len(out)
+		n := min(count-start, growBy)
+		out = slices.Grow(out, n)[:start+n]
+		if err := ReadArrayInto(in, endian, out[start:]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,105 @@
+package vector4_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+type arrayReadWriteTestCase[T vector.Number] struct {
+	len int
+}
+
+func (tc arrayReadWriteTestCase[T]) test(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	arr := make(vector4.Array[T], tc.len)
+	for i := range arr {
+		arr[i] = vector4.New(T(r.NormFloat64()*100), T(r.NormFloat64()*100), T(r.NormFloat64()*100), T(r.NormFloat64()*100))
+	}
+
+	for _, endian := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		// Matches writing each vector individually
+		individually := &bytes.Buffer{}
+		for _, v := range arr {
+			assert.NoError(t, v.Write(individually, endian))
+		}
+
+		buf := &bytes.Buffer{}
+		assert.NoError(t, arr.Write(buf, endian))
+		assert.Equal(t, individually.Bytes(), buf.Bytes())
+
+		back, err := vector4.ReadArray[T](bytes.NewReader(buf.Bytes()), endian, len(arr))
+		assert.NoError(t, err)
+		assert.Equal(t, arr, back)
+
+		into := make(vector4.Array[T], len(arr))
+		assert.NoError(t, vector4.ReadArrayInto(bytes.NewReader(buf.Bytes()), endian, into))
+		assert.Equal(t, arr, into)
+
+		withHeader := &bytes.Buffer{}
+		assert.NoError(t, arr.WriteWithHeader(withHeader, endian))
+		assert.Equal(t, buf.Len()+10, withHeader.Len())
+
+		back, err = vector4.ReadArrayWithHeader[T](withHeader, endian)
+		assert.NoError(t, err)
+		assert.Equal(t, arr, back)
+	}
+}
+
+func TestArrayReadWrite(t *testing.T) {
+	tests := map[string]testCaseI{
+		"float64":       arrayReadWriteTestCase[float64]{len: 100},
+		"float64 large": arrayReadWriteTestCase[float64]{len: 10_000},
+		"float32":       arrayReadWriteTestCase[float32]{len: 10_000},
+		"int8":          arrayReadWriteTestCase[int8]{len: 100},
+		"int16":         arrayReadWriteTestCase[int16]{len: 100},
+		"int32":         arrayReadWriteTestCase[int32]{len: 10_000},
+		"int64":         arrayReadWriteTestCase[int64]{len: 100},
+		"empty":         arrayReadWriteTestCase[float32]{len: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.test(t)
+		})
+	}
+}
+
+func TestReadArrayHeader(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := vector4.Float32Array{vector4.New[float32](1, 2, 3, 4), vector4.New[float32](5, 6, 7, 8)}
+	assert.NoError(t, arr.WriteWithHeader(buf, binary.BigEndian))
+
+	data := buf.Bytes()
+	count, err := vector4.ReadArrayHeader[float32](bytes.NewReader(data), binary.BigEndian)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = vector4.ReadArrayHeader[float64](bytes.NewReader(data), binary.BigEndian)
+	assert.EqualError(t, err, "array header component type 5 does not match expected type 6")
+
+	vector2Header := append([]byte{5, 2}, data[2:]...)
+	_, err = vector4.ReadArrayHeader[float32](bytes.NewReader(vector2Header), binary.BigEndian)
+	assert.EqualError(t, err, "array header component count 2 does not match expected count 4")
+
+	_, err = vector4.ReadArrayHeader[float32](bytes.NewReader(data[:4]), binary.BigEndian)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestReadArray_Truncated(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := make(vector4.Float64Array, 5000)
+	assert.NoError(t, arr.WriteWithHeader(buf, binary.BigEndian))
+
+	_, err := vector4.ReadArrayWithHeader[float64](bytes.NewReader(buf.Bytes()[:buf.Len()-1]), binary.BigEndian)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = vector4.ReadArray[float64](bytes.NewReader(buf.Bytes()[10:100]), binary.LittleEndian, 5000)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,105 @@
+package vector4_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector"
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertEncodedInDelta[T vector.Number](t *testing.T, expected vector4.Float64Array, actual vector4.Array[T], delta float64) {
+	t.Helper()
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		if !assert.InDelta(t, expected[i].X(), float64(actual[i].X()), delta) ||
+			!assert.InDelta(t, expected[i].Y(), float64(actual[i].Y()), delta) ||
+			!assert.InDelta(t, expected[i].Z(), float64(actual[i].Z()), delta) ||
+			!assert.InDelta(t, expected[i].W(), float64(actual[i].W()), delta) {
+			return
+		}
+	}
+}
+
+func TestArrayReadWriteEncoded(t *testing.T) {
+	tests := map[string]struct {
+		encoding vector.Encoding
+		lo, hi   float64
+		delta    float64
+	}{
+		"half":    {encoding: vector.Half, lo: -1000, hi: 1000, delta: 0.5},
+		"snorm8":  {encoding: vector.SNORM8, lo: -1, hi: 1, delta: 0.5 / 127},
+		"snorm16": {encoding: vector.SNORM16, lo: -1, hi: 1, delta: 0.5 / 32767},
+		"unorm8":  {encoding: vector.UNORM8, lo: 0, hi: 1, delta: 0.5 / 255},
+		"unorm16": {encoding: vector.UNORM16, lo: 0, hi: 1, delta: 0.5 / 65535},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			arr := make(vector4.Float64Array, 25_000)
+			for i := range arr {
+				arr[i] = vector4.New(r.Float64(), r.Float64(), r.Float64(), r.Float64()).
+					Scale(tc.hi - tc.lo).
+					Add(vector4.Fill(tc.lo))
+			}
+
+			for _, endian := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+				buf := &bytes.Buffer{}
+				assert.NoError(t, arr.WriteEncoded(buf, endian, tc.encoding))
+				assert.Equal(t, len(arr)*4*tc.encoding.Size(), buf.Len())
+
+				// Matches writing each vector individually
+				individually := &bytes.Buffer{}
+				for _, v := range arr[:100] {
+					assert.NoError(t, v.WriteEncoded(individually, endian, tc.encoding))
+				}
+				assert.Equal(t, individually.Bytes(), buf.Bytes()[:individually.Len()])
+
+				v, err := vector4.ReadEncoded[float64](bytes.NewReader(buf.Bytes()), endian, tc.encoding)
+				assert.NoError(t, err)
+				assert.InDelta(t, arr[0].X(), v.X(), tc.delta)
+
+				back, err := vector4.ReadArrayEncoded[float64](bytes.NewReader(buf.Bytes()), endian, tc.encoding, len(arr))
+				assert.NoError(t, err)
+				assertEncodedInDelta(t, arr, back, tc.delta)
+
+				// Float32 arrays decode identically
+				back32, err := vector4.ReadArrayEncoded[float32](bytes.NewReader(buf.Bytes()), endian, tc.encoding, len(arr))
+				assert.NoError(t, err)
+				assertEncodedInDelta(t, back, back32, 1e-6)
+
+				// Encoding is stable once values are quantized
+				again := &bytes.Buffer{}
+				assert.NoError(t, back.WriteEncoded(again, endian, tc.encoding))
+				assert.Equal(t, buf.Bytes(), again.Bytes())
+			}
+		})
+	}
+}
+
+func TestWriteEncoded_Clamps(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, vector4.New(-3., 0.25, 7., 1.).WriteEncoded(buf, binary.LittleEndian, vector.UNORM8))
+	assert.Equal(t, []byte{0, 64, 255, 255}, buf.Bytes())
+
+	v, err := vector4.ReadEncoded[float64](buf, binary.LittleEndian, vector.UNORM8)
+	assert.NoError(t, err)
+	assert.Equal(t, vector4.New(0., 64./255., 1., 1.), v)
+}
+
+func TestReadArrayEncoded_Truncated(t *testing.T) {
+	buf := &bytes.Buffer{}
+	arr := make(vector4.Float64Array, 100)
+	assert.NoError(t, arr.WriteEncoded(buf, binary.BigEndian, vector.Half))
+
+	_, err := vector4.ReadArrayEncoded[float64](bytes.NewReader(buf.Bytes()[:buf.Len()-1]), binary.BigEndian, vector.Half, len(arr))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = vector4.ReadEncoded[float64](bytes.NewReader(nil), binary.BigEndian, vector.Half)
+	assert.ErrorIs(t, err, io.EOF)
+}
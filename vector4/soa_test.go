@@ -0,0 +1,124 @@
+package vector4_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertArrayInDelta(t *testing.T, expected, actual vector4.Float64Array) {
+	t.Helper()
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.InDelta(t, expected[i].X(), actual[i].X(), 0.000001)
+		assert.InDelta(t, expected[i].Y(), actual[i].Y(), 0.000001)
+		assert.InDelta(t, expected[i].Z(), actual[i].Z(), 0.000001)
+		assert.InDelta(t, expected[i].W(), actual[i].W(), 0.000001)
+	}
+}
+
+func TestSoA_RoundTrip(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+	assert.Equal(t, len(arr), soa.Len())
+	assert.Len(t, soa.W, len(arr))
+
+	for i, v := range arr {
+		assert.Equal(t, v, soa.At(i))
+		assert.Equal(t, v.W(), soa.W[i])
+	}
+	assert.Equal(t, arr, soa.ToArray())
+
+	soa.Set(3, vector4.New(1., 2., 3., 4.))
+	assert.Equal(t, vector4.New(1., 2., 3., 4.), soa.At(3))
+	assert.NotEqual(t, vector4.New(1., 2., 3., 4.), arr[3])
+}
+
+func TestSoA_MatchesArray(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	other := vector4.New(1., -2., 3., -4.)
+
+	tests := map[string]struct {
+		array func(vector4.Float64Array) vector4.Float64Array
+		soa   func(vector4.Float64SoA) vector4.Float64SoA
+	}{
+		"add": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.Add(other) },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.Add(other) },
+		},
+		"sub": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.Sub(other) },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.Sub(other) },
+		},
+		"scale": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.Scale(2.5) },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.Scale(2.5) },
+		},
+		"div by constant": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.DivByConstant(3) },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.DivByConstant(3) },
+		},
+		"normalized": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.Normalized() },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.Normalized() },
+		},
+		"modify": {
+			array: func(a vector4.Float64Array) vector4.Float64Array { return a.Modify(vector4.Float64.Abs) },
+			soa:   func(s vector4.Float64SoA) vector4.Float64SoA { return s.Modify(vector4.Float64.Abs) },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			soa := arr.ToSoA()
+			assertArrayInDelta(t, tc.array(arr), tc.soa(soa).ToArray())
+			assert.Equal(t, arr, soa.ToArray())
+		})
+	}
+}
+
+func TestSoA_InplaceMatchesArray(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+
+	arr.AddInplace(vector4.New(1., 2., 3., 4.)).ScaleInplace(0.5).SubInplace(vector4.Fill(4.))
+	soa.AddInplace(vector4.New(1., 2., 3., 4.)).ScaleInplace(0.5).SubInplace(vector4.Fill(4.))
+
+	assertArrayInDelta(t, arr, soa.ToArray())
+}
+
+func TestSoA_ReductionsMatchArray(t *testing.T) {
+	arr := randomArray(rand.New(rand.NewSource(42)), 100)
+	soa := arr.ToSoA()
+
+	assert.InDelta(t, arr.Distance(), soa.Distance(), 0.000001)
+	assert.InDelta(t, arr.MaxLength(), soa.MaxLength(), 0.000001)
+	assertArrayInDelta(t, vector4.Float64Array{arr.Sum()}, vector4.Float64Array{soa.Sum()})
+
+	mean, deviation := arr.StandardDeviation()
+	soaMean, soaDeviation := soa.StandardDeviation()
+	assertArrayInDelta(t, vector4.Float64Array{mean, deviation}, vector4.Float64Array{soaMean, soaDeviation})
+	assertArrayInDelta(t, vector4.Float64Array{arr.Average()}, vector4.Float64Array{soa.Average()})
+
+	min, max := arr.Bounds()
+	soaMin, soaMax := soa.Bounds()
+	assert.Equal(t, min, soaMin)
+	assert.Equal(t, max, soaMax)
+}
+
+func TestSoA_ContainsNaN(t *testing.T) {
+	soa := vector4.NewSoA[float64](3)
+	assert.False(t, soa.ContainsNaN())
+
+	soa.Set(1, vector4.New(0., 0., 0., math.NaN()))
+	assert.True(t, soa.ContainsNaN())
+}
+
+func TestSoA_Bounds_PanicsOnZeroPoints(t *testing.T) {
+	assert.PanicsWithError(t, "can not compute bounds from 0 vector elements", func() {
+		vector4.NewSoA[float64](0).Bounds()
+	})
+}
@@ -0,0 +1,63 @@
+package vector4
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/EliCDavis/vector"
+)
+
+// Flat returns a view of the array as a flat slice of components, laid out
+// as x0, y0, z0, w0, x1, y1, and so on. No data is copied, so writes through
+// either slice are visible through the other. Appending to either slice may
+// reallocate it, after which they no longer share memory.
+func (v4a Array[T]) Flat() []T {
+	if len(v4a) == 0 {
+		return []T{}
+	}
+	return unsafe.Slice((*T)(unsafe.Pointer(unsafe.SliceData(v4a))), len(v4a)*componentCount)
+}
+
+// Bytes returns a view of the array's memory as bytes, in the machine's
+// native byte order (binary.NativeEndian). No data is copied, so the same
+// aliasing rules as Flat apply.
+func (v4a Array[T]) Bytes() []byte {
+	if len(v4a) == 0 {
+		return []byte{}
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(v4a))), len(v4a)*int(unsafe.Sizeof(v4a[0])))
+}
+
+// ArrayFromFlat views a flat slice of components, laid out as
+// x0, y0, z0, w0, x1, y1, and so on, as an array of vectors without copying.
+// The slice's length must be a multiple of 4.
+func ArrayFromFlat[T vector.Number](data []T) (Array[T], error) {
+	if len(data)%componentCount != 0 {
+		return nil, fmt.Errorf("flat data length %d is not a multiple of %d", len(data), componentCount)
+	}
+	if len(data) == 0 {
+		return Array[T]{}, nil
+	}
+	return unsafe.Slice((*Vector[T])(unsafe.Pointer(unsafe.SliceData(data))), len(data)/componentCount), nil
+}
+
+// ArrayFromBytes views bytes holding components in the machine's native
+// byte order as an array of vectors without copying. The data's length must
+// be a multiple of the vector's size, and its start must be aligned for T,
+// which is always true for slices allocated as T and then viewed as bytes.
+func ArrayFromBytes[T vector.Number](data []byte) (Array[T], error) {
+	var v Vector[T]
+	size := int(unsafe.Sizeof(v))
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("byte data length %d is not a multiple of the vector size %d", len(data), size)
+	}
+	if len(data) == 0 {
+		return Array[T]{}, nil
+	}
+
+	ptr := unsafe.Pointer(unsafe.SliceData(data))
+	if align := unsafe.Alignof(v); uintptr(ptr)%align != 0 {
+		return nil, fmt.Errorf("byte data is not aligned to %d bytes", align)
+	}
+	return unsafe.Slice((*Vector[T])(ptr), len(data)/size), nil
+}
@@ -0,0 +1,51 @@
+package vector4_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/EliCDavis/vector/vector4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArray_Flat(t *testing.T) {
+	arr := vector4.Float32Array{
+		vector4.New[float32](1, 2, 3, 4),
+		vector4.New[float32](5, 6, 7, 8),
+	}
+
+	flat := arr.Flat()
+	assert.Equal(t, []float32{1, 2, 3, 4, 5, 6, 7, 8}, flat)
+
+	flat[7] = 80
+	assert.Equal(t, vector4.New[float32](5, 6, 7, 80), arr[1])
+
+	back, err := vector4.ArrayFromFlat(flat)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+
+	_, err = vector4.ArrayFromFlat([]float32{1, 2, 3, 4, 5, 6})
+	assert.EqualError(t, err, "flat data length 6 is not a multiple of 4")
+}
+
+func TestArray_Bytes(t *testing.T) {
+	arr := vector4.Float64Array{
+		vector4.New(1., -2., 3., -4.),
+	}
+
+	bytes := arr.Bytes()
+	assert.Len(t, bytes, 32)
+
+	back, err := vector4.ArrayFromBytes[float64](bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, arr, back)
+
+	_, err = vector4.ArrayFromBytes[float64](bytes[:16])
+	assert.EqualError(t, err, "byte data length 16 is not a multiple of the vector size 32")
+}
+
+func TestVector_HasNoPadding(t *testing.T) {
+	assert.Equal(t, uintptr(4), unsafe.Sizeof(vector4.Int8{}))
+	assert.Equal(t, uintptr(16), unsafe.Sizeof(vector4.Float32{}))
+	assert.Equal(t, uintptr(32), unsafe.Sizeof(vector4.Float64{}))
+}